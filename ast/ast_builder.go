@@ -47,7 +47,11 @@ func (v *Builder) VisitTypeDeclaration(ctx *parser.TypeDeclarationContext) inter
 		decl.Annotations = setParentNodeToAnnotations(annotations, decl)
 		return decl
 	} else if n := ctx.EnumDeclaration(); n != nil {
-		return n.Accept(v)
+		ed := n.Accept(v)
+		decl := ed.(*EnumDeclaration)
+		decl.Modifiers = setParentNodeToModifiers(modifiers, decl)
+		decl.Annotations = setParentNodeToAnnotations(annotations, decl)
+		return decl
 	}
 	panic("not pass")
 }
@@ -126,15 +130,29 @@ func (v *Builder) VisitClassDeclaration(ctx *parser.ClassDeclarationContext) int
 }
 
 func (v *Builder) VisitEnumDeclaration(ctx *parser.EnumDeclarationContext) interface{} {
-	return v.VisitChildren(ctx)
+	n := &EnumDeclaration{
+		Name:     ctx.ApexIdentifier().GetText(),
+		Location: v.newLocation(ctx),
+	}
+	if constants := ctx.EnumConstants(); constants != nil {
+		n.Identifiers = constants.Accept(v).([]string)
+	} else {
+		n.Identifiers = []string{}
+	}
+	return n
 }
 
 func (v *Builder) VisitEnumConstants(ctx *parser.EnumConstantsContext) interface{} {
-	return v.VisitChildren(ctx)
+	constants := ctx.AllEnumConstant()
+	identifiers := make([]string, len(constants))
+	for i, c := range constants {
+		identifiers[i] = c.Accept(v).(string)
+	}
+	return identifiers
 }
 
 func (v *Builder) VisitEnumConstant(ctx *parser.EnumConstantContext) interface{} {
-	return v.VisitChildren(ctx)
+	return ctx.ApexIdentifier().GetText()
 }
 
 func (v *Builder) VisitEnumBodyDeclarations(ctx *parser.EnumBodyDeclarationsContext) interface{} {
@@ -216,9 +234,10 @@ func (v *Builder) VisitClassBodyDeclaration(ctx *parser.ClassBodyDeclarationCont
 			decl.Modifiers = declarationModifiers
 			decl.Annotations = declarationAnnotations
 			return decl
-		//case *EnumDeclaration:
-		//	decl.Modifiers = declarationModifiers
-		//	return decl
+		case *EnumDeclaration:
+			decl.Modifiers = declarationModifiers
+			decl.Annotations = declarationAnnotations
+			return decl
 		case *PropertyDeclaration:
 			decl.Modifiers = declarationModifiers
 			decl.Annotations = declarationAnnotations
@@ -597,6 +616,12 @@ func (v *Builder) VisitAnnotation(ctx *parser.AnnotationContext) interface{} {
 	// TODO: implement annotationName
 	annotation.Name = name.Name[0]
 	annotation.Location = v.newLocation(ctx)
+	if pairs := ctx.ElementValuePairs(); pairs != nil {
+		annotation.Parameters = pairs.Accept(v).([]Node)
+	} else if value := ctx.ElementValue(); value != nil {
+		annotation.Parameters = []Node{value.Accept(v).(Node)}
+	}
+	setParentNodeToNodes(annotation.Parameters, annotation)
 	return annotation
 }
 
@@ -613,11 +638,17 @@ func (v *Builder) VisitElementValuePairs(ctx *parser.ElementValuePairsContext) i
 	return pairs
 }
 
+// VisitElementValuePair returns the pair such as `callout=true` as an assignment of the value to the name
 func (v *Builder) VisitElementValuePair(ctx *parser.ElementValuePairContext) interface{} {
-	ctx.ApexIdentifier().GetText()
-	ctx.ElementValue().Accept(v)
-	// TODO: implement
-	return nil
+	pair := &BinaryOperator{
+		Op:       "=",
+		Left:     &Name{Value: []string{ctx.ApexIdentifier().GetText()}, Location: v.newLocation(ctx)},
+		Right:    ctx.ElementValue().Accept(v).(Node),
+		Location: v.newLocation(ctx),
+	}
+	pair.Left.(*Name).Parent = pair
+	pair.Right.SetParent(pair)
+	return pair
 }
 
 func (v *Builder) VisitElementValue(ctx *parser.ElementValueContext) interface{} {
@@ -834,9 +865,20 @@ func (v *Builder) VisitWhenExpression(ctx *parser.WhenExpressionContext) interfa
 		}
 		return expressions
 	}
+	if ctx.ApexType() == nil {
+		identifiers := ctx.AllApexIdentifier()
+		expressions := make([]Node, len(identifiers))
+		for i, identifier := range identifiers {
+			expressions[i] = &Name{
+				Value:    []string{identifier.GetText()},
+				Location: v.newLocation(identifier),
+			}
+		}
+		return expressions
+	}
 	n := &WhenType{Location: v.newLocation(ctx)}
 	n.TypeRef = ctx.ApexType().Accept(v).(*TypeRef)
-	n.Identifier = ctx.ApexIdentifier().GetText()
+	n.Identifier = ctx.ApexIdentifier(0).GetText()
 	return []Node{n}
 }
 
@@ -1336,7 +1378,7 @@ func (v *Builder) VisitQuery(ctx *parser.QueryContext) interface{} {
 		n.Group = group.Accept(v).(*Group)
 	}
 	if order := ctx.OrderClause(); order != nil {
-		n.Order = order.Accept(v).(Node)
+		n.Order = order.Accept(v).([]*Order)
 	}
 	if limit := ctx.LimitClause(); limit != nil {
 		n.Limit = limit.Accept(v).(Node)
@@ -1362,7 +1404,16 @@ func (v *Builder) VisitFieldList(ctx *parser.FieldListContext) interface{} {
 
 func (v *Builder) VisitSelectField(ctx *parser.SelectFieldContext) interface{} {
 	if t := ctx.SoqlField(); t != nil {
-		return t.Accept(v)
+		field := t.Accept(v)
+		if alias := ctx.ApexIdentifier(0); alias != nil {
+			switch f := field.(type) {
+			case *SelectField:
+				f.Alias = alias.GetText()
+			case *SoqlFunction:
+				f.Alias = alias.GetText()
+			}
+		}
+		return field
 	}
 	if t := ctx.Subquery(); t != nil {
 		return t.Accept(v)
@@ -1389,10 +1440,12 @@ func (v *Builder) VisitSoqlFieldReference(ctx *parser.SoqlFieldReferenceContext)
 }
 
 func (v *Builder) VisitSoqlFunctionCall(ctx *parser.SoqlFunctionCallContext) interface{} {
-	n := &SoqlFunction{}
+	n := &SoqlFunction{Location: v.newLocation(ctx)}
 	n.Name = ctx.ApexIdentifier().GetText()
-	for _, f := range ctx.AllSoqlField() {
-		f.Accept(v)
+	fields := ctx.AllSoqlField()
+	n.Fields = make([]Node, len(fields))
+	for i, f := range fields {
+		n.Fields[i] = f.Accept(v).(Node)
 	}
 	return n
 }
@@ -1417,6 +1470,9 @@ func (v *Builder) VisitWhereFields(ctx *parser.WhereFieldsContext) interface{} {
 }
 
 func (v *Builder) VisitWhereField(ctx *parser.WhereFieldContext) interface{} {
+	if f := ctx.WhereFields(); f != nil {
+		return f.Accept(v)
+	}
 	n := &WhereCondition{Location: v.newLocation(ctx)}
 	n.Field = ctx.SoqlField().Accept(v).(Node)
 	n.Not = ctx.SOQL_NOT() != nil
@@ -1436,21 +1492,36 @@ func (v *Builder) VisitLimitClause(ctx *parser.LimitClauseContext) interface{} {
 	return ctx.BindVariable().Accept(v)
 }
 
+// VisitOrderClause returns the fields of ORDER BY, each of which has its own direction and null ordering
 func (v *Builder) VisitOrderClause(ctx *parser.OrderClauseContext) interface{} {
-	n := &Order{Location: v.newLocation(ctx)}
-	fields := make([]Node, len(ctx.AllSoqlField()))
-	for i, f := range ctx.AllSoqlField() {
-		fields[i] = f.Accept(v).(Node)
-	}
-	n.Asc = ctx.GetAsc_desc().GetText() == "asc"
-	if nulls := ctx.GetNulls(); nulls != nil {
-		n.Nulls = nulls.GetText()
+	orders := []*Order{}
+	var order *Order
+	for _, child := range ctx.GetChildren() {
+		switch c := child.(type) {
+		case parser.ISoqlFieldContext:
+			order = &Order{
+				Field:    c.Accept(v).(Node),
+				Asc:      true,
+				Location: v.newLocation(c),
+			}
+			orders = append(orders, order)
+		case antlr.TerminalNode:
+			switch text := strings.ToUpper(c.GetText()); text {
+			case "DESC":
+				order.Asc = false
+			case "FIRST", "LAST":
+				order.Nulls = text
+			}
+		}
 	}
-	return n
+	return orders
 }
 
 func (v *Builder) VisitBindVariable(ctx *parser.BindVariableContext) interface{} {
-	return ctx.Expression().Accept(v)
+	n := &SoqlBindVariable{Location: v.newLocation(ctx)}
+	n.Expression = ctx.Expression().Accept(v).(Node)
+	n.Expression.SetParent(n)
+	return n
 }
 
 func (v *Builder) VisitSoqlValue(ctx *parser.SoqlValueContext) interface{} {
@@ -1476,6 +1547,12 @@ func (v *Builder) VisitGroupClause(ctx *parser.GroupClauseContext) interface{} {
 	for i, f := range ctx.AllSoqlField() {
 		fields[i] = f.Accept(v).(Node)
 	}
+	// GROUP BY ROLLUP(a, b) and CUBE(a, b) are the functions of the fields
+	if t := ctx.ROLLUP(); t != nil {
+		fields = []Node{&SoqlFunction{Name: t.GetText(), Fields: fields, Location: v.newLocation(ctx)}}
+	} else if t := ctx.CUBE(); t != nil {
+		fields = []Node{&SoqlFunction{Name: t.GetText(), Fields: fields, Location: v.newLocation(ctx)}}
+	}
 	var having Node
 	if ctx.HavingConditionExpression() != nil {
 		having = ctx.HavingConditionExpression().Accept(v).(Node)
@@ -1506,15 +1583,49 @@ func (v *Builder) VisitViewClause(ctx *parser.ViewClauseContext) interface{} {
 }
 
 func (v *Builder) VisitSoslLiteral(ctx *parser.SoslLiteralContext) interface{} {
-	return v.VisitChildren(ctx)
+	return ctx.SoslQuery().Accept(v)
 }
 
+// VisitSoslQuery returns Sosl searching in ALL FIELDS if the search group is omitted
 func (v *Builder) VisitSoslQuery(ctx *parser.SoslQueryContext) interface{} {
-	return v.VisitChildren(ctx)
+	n := &Sosl{Location: v.newLocation(ctx)}
+	if lit := ctx.StringLiteral(); lit != nil {
+		str := lit.GetText()
+		n.SearchTerm = &StringLiteral{Value: str[1 : len(str)-1], Location: v.newLocation(ctx)}
+	} else {
+		n.SearchTerm = ctx.BindVariable().Accept(v).(Node)
+	}
+	n.SearchGroup = "ALL"
+	if group := ctx.Identifier(); group != nil {
+		n.SearchGroup = strings.ToUpper(group.GetText())
+	}
+	returningObjects := ctx.AllSoslReturningObject()
+	n.Returning = make([]*Soql, len(returningObjects))
+	for i, r := range returningObjects {
+		n.Returning[i] = r.Accept(v).(*Soql)
+	}
+	return n
 }
 
+// VisitSoslReturningObject returns the query of the sObject, which selects Id if the fields are omitted
 func (v *Builder) VisitSoslReturningObject(ctx *parser.SoslReturningObjectContext) interface{} {
-	return v.VisitChildren(ctx)
+	n := &Soql{Location: v.newLocation(ctx)}
+	n.FromObject = ctx.Identifier().GetText()
+	fields := ctx.AllSoqlField()
+	n.SelectFields = make([]Node, len(fields))
+	for i, f := range fields {
+		n.SelectFields[i] = f.Accept(v).(Node)
+	}
+	if len(fields) == 0 {
+		n.SelectFields = []Node{&SelectField{Value: []string{"Id"}, Location: v.newLocation(ctx)}}
+	}
+	if where := ctx.WhereClause(); where != nil {
+		n.Where = where.Accept(v).(Node)
+	}
+	if limit := ctx.LimitClause(); limit != nil {
+		n.Limit = limit.Accept(v).(Node)
+	}
+	return n
 }
 
 type LocationContext interface {
@@ -23,6 +23,8 @@ type ClassType struct {
 	Extra              map[string]interface{}
 	Generics           []*ClassType
 	Interface          bool
+	Enum               bool
+	Trigger            *Trigger
 	Location           *Location
 	Parent             Node
 }
@@ -31,6 +33,14 @@ func (t *ClassType) IsInterface() bool {
 	return t.Interface
 }
 
+func (t *ClassType) IsEnum() bool {
+	return t.Enum
+}
+
+func (t *ClassType) IsTrigger() bool {
+	return t.Trigger != nil
+}
+
 func (t *ClassType) IsAbstract() bool {
 	return t.Is("abstract")
 }
@@ -43,7 +53,9 @@ func (t *ClassType) IsGenerics() bool {
 	return t.Name == "List" ||
 		t.Name == "Map" ||
 		t.Name == "Set" ||
-		t.Name == "Batchable"
+		t.Name == "Batchable" ||
+		t.Name == "Iterable" ||
+		t.Name == "Iterator"
 }
 
 func (t *ClassType) String() string {
@@ -79,6 +91,16 @@ func (t *ClassType) Is(name string) bool {
 	return false
 }
 
+// Annotation returns the annotation of the class, or nil if the class is not annotated
+func (t *ClassType) Annotation(name string) *Annotation {
+	for _, annotation := range t.Annotations {
+		if strings.EqualFold(annotation.Name, name) {
+			return annotation
+		}
+	}
+	return nil
+}
+
 type Field struct {
 	TypeRef    *TypeRef
 	Type       *ClassType
@@ -237,13 +259,31 @@ func (m *Method) IsTestMethod() bool {
 }
 
 func (m *Method) IsAnnotated(name string) bool {
-	name = strings.ToLower(name)
+	return m.Annotation(name) != nil
+}
+
+// Annotation returns the annotation of the method, or nil if the method is not annotated
+func (m *Method) Annotation(name string) *Annotation {
 	for _, annotation := range m.Annotations {
-		if strings.ToLower(annotation.Name) == name {
-			return true
+		if strings.EqualFold(annotation.Name, name) {
+			return annotation
 		}
 	}
-	return false
+	return nil
+}
+
+// Parameter returns the value of the element value pair of the annotation, such as true of @future(callout=true)
+func (a *Annotation) Parameter(name string) (Node, bool) {
+	for _, parameter := range a.Parameters {
+		pair, ok := parameter.(*BinaryOperator)
+		if !ok || pair.Op != "=" {
+			continue
+		}
+		if key, ok := pair.Left.(*Name); ok && strings.EqualFold(key.Value[0], name) {
+			return pair.Right, true
+		}
+	}
+	return nil, false
 }
 
 func (m *Method) AccessModifier() string {
@@ -392,49 +432,6 @@ func CreateField(
 	}
 }
 
-func CreateEnum(name string, enums []string) *ClassType {
-	classType := &ClassType{
-		Name:            name,
-		Modifiers:       []*Modifier{PublicModifier()},
-		InstanceFields:  NewFieldMap(),
-		InstanceMethods: NewMethodMap(),
-		StaticMethods:   NewMethodMap(),
-		InnerClasses:    NewClassMap(),
-	}
-	classType.Constructors = []*Method{
-		{
-			Modifiers: []*Modifier{PublicModifier()},
-			Parameters: []*Parameter{
-				{
-					Type: classType,
-					Name: "_",
-				},
-			},
-			NativeFunction: func(this *Object, params []*Object, extra map[string]interface{}) interface{} {
-				this.Extra["value"] = params[0]
-				return nil
-			},
-		},
-	}
-
-	fields := NewFieldMap()
-	for _, enum := range enums {
-		fields.Set(enum, &Field{
-			Name:      name,
-			Modifiers: []*Modifier{PublicModifier()},
-			Type:      classType,
-			Expression: &New{
-				Type: classType,
-				Parameters: []Node{
-					&StringLiteral{Value: enum},
-				},
-			},
-		})
-	}
-	classType.StaticFields = fields
-	return classType
-}
-
 var publicModifier = &Modifier{Name: "public"}
 var privateModifier = &Modifier{Name: "private"}
 var protectedModifier = &Modifier{Name: "protected"}
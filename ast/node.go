@@ -2,6 +2,7 @@ package ast
 
 import (
 	"fmt"
+	"strings"
 )
 
 type Location struct {
@@ -44,6 +45,15 @@ type InterfaceDeclaration struct {
 	Parent      Node
 }
 
+type EnumDeclaration struct {
+	Annotations []*Annotation
+	Modifiers   []*Modifier
+	Name        string
+	Identifiers []string
+	Location    *Location
+	Parent      Node
+}
+
 type IntegerLiteral struct {
 	Value    int
 	Location *Location
@@ -254,7 +264,7 @@ type Soql struct {
 	FromObject   string
 	Where        Node
 	Group        *Group
-	Order        Node
+	Order        []*Order
 	Limit        Node
 	Offset       Node
 	ExactlyOne   bool
@@ -264,6 +274,7 @@ type Soql struct {
 
 type SelectField struct {
 	Value    []string
+	Alias    string
 	Location *Location
 	Parent   Node
 	*NoopAccepter
@@ -271,6 +282,8 @@ type SelectField struct {
 
 type SoqlFunction struct {
 	Name     string
+	Fields   []Node
+	Alias    string
 	Location *Location
 	Parent   Node
 	*NoopAccepter
@@ -295,8 +308,9 @@ type WhereCondition struct {
 	*NoopAccepter
 }
 
+// Order is the field of ORDER BY, Nulls is FIRST or LAST if it is specified
 type Order struct {
-	Field    []Node
+	Field    Node
 	Asc      bool
 	Nulls    string
 	Location *Location
@@ -309,9 +323,15 @@ type Group struct {
 	Having Node
 }
 
+// Sosl is the SOSL query such as FIND 'Acme' IN NAME FIELDS RETURNING Account(Id, Name WHERE Type = 'Customer' LIMIT 10).
+// SearchTerm is StringLiteral or SoqlBindVariable, SearchGroup is ALL, NAME, EMAIL, PHONE or SIDEBAR,
+// and Returning are the queries of the sObjects to search, whose FromObject are the sObject names
 type Sosl struct {
-	Location *Location
-	Parent   Node
+	SearchTerm  Node
+	SearchGroup string
+	Returning   []*Soql
+	Location    *Location
+	Parent      Node
 }
 
 type StringLiteral struct {
@@ -504,6 +524,7 @@ type Visitor interface {
 	VisitModifier(*Modifier) (interface{}, error)
 	VisitAnnotation(*Annotation) (interface{}, error)
 	VisitInterfaceDeclaration(*InterfaceDeclaration) (interface{}, error)
+	VisitEnumDeclaration(*EnumDeclaration) (interface{}, error)
 	VisitIntegerLiteral(*IntegerLiteral) (interface{}, error)
 	VisitParameter(*Parameter) (interface{}, error)
 	VisitArrayAccess(*ArrayAccess) (interface{}, error)
@@ -614,6 +635,19 @@ func (n *InterfaceDeclaration) GetChildren() []interface{} {
 	}
 }
 
+func (n *EnumDeclaration) Accept(v Visitor) (interface{}, error) {
+	return v.VisitEnumDeclaration(n)
+}
+
+func (n *EnumDeclaration) GetChildren() []interface{} {
+	return []interface{}{
+		n.Name,
+		n.Annotations,
+		n.Identifiers,
+		n.Modifiers,
+	}
+}
+
 func (n *IntegerLiteral) Accept(v Visitor) (interface{}, error) {
 	return v.VisitIntegerLiteral(n)
 }
@@ -914,6 +948,7 @@ func (n *Soql) GetChildren() []interface{} {
 		n.Where,
 		n.Order,
 		n.Limit,
+		n.Offset,
 	}
 }
 
@@ -922,7 +957,14 @@ func (n *Sosl) Accept(v Visitor) (interface{}, error) {
 }
 
 func (n *Sosl) GetChildren() []interface{} {
-	return []interface{}{}
+	returning := make([]Node, len(n.Returning))
+	for i, soql := range n.Returning {
+		returning[i] = soql
+	}
+	return []interface{}{
+		n.SearchTerm,
+		returning,
+	}
 }
 
 func (n *StringLiteral) Accept(v Visitor) (interface{}, error) {
@@ -960,6 +1002,16 @@ func (n *Trigger) GetChildren() []interface{} {
 	}
 }
 
+func (n *Trigger) HasTiming(timing, dml string) bool {
+	for _, t := range n.TriggerTimings {
+		triggerTiming := t.(*TriggerTiming)
+		if strings.EqualFold(triggerTiming.Timing, timing) && strings.EqualFold(triggerTiming.Dml, dml) {
+			return true
+		}
+	}
+	return false
+}
+
 func (n *TriggerTiming) Accept(v Visitor) (interface{}, error) {
 	return v.VisitTriggerTiming(n)
 }
@@ -1241,6 +1293,10 @@ func (n *Annotation) GetType() string {
 func (n *InterfaceDeclaration) GetType() string {
 	return "InterfaceDeclaration"
 }
+
+func (n *EnumDeclaration) GetType() string {
+	return "EnumDeclaration"
+}
 func (n *IntegerLiteral) GetType() string {
 	return "Integer"
 }
@@ -1427,6 +1483,10 @@ func (n *Annotation) GetParent() Node {
 func (n *InterfaceDeclaration) GetParent() Node {
 	return n.Parent
 }
+
+func (n *EnumDeclaration) GetParent() Node {
+	return n.Parent
+}
 func (n *IntegerLiteral) GetParent() Node {
 	return n.Parent
 }
@@ -1617,6 +1677,10 @@ func (n *InterfaceDeclaration) SetParent(parent Node) {
 	n.Parent = parent
 }
 
+func (n *EnumDeclaration) SetParent(parent Node) {
+	n.Parent = parent
+}
+
 func (n *IntegerLiteral) SetParent(parent Node) {
 	n.Parent = parent
 }
@@ -1857,6 +1921,10 @@ func (n *InterfaceDeclaration) GetLocation() *Location {
 	return n.Location
 }
 
+func (n *EnumDeclaration) GetLocation() *Location {
+	return n.Location
+}
+
 func (n *IntegerLiteral) GetLocation() *Location {
 	return n.Location
 }
@@ -1,7 +1,10 @@
 package ast
 
 import (
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"strings"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/tzmfreedom/land/parser"
@@ -32,7 +35,7 @@ func ParseString(src string, processors ...PreProcessor) (Node, error) {
 
 func parse(input antlr.CharStream, src string) Node {
 	lexer := parser.NewapexLexer(input)
-	stream := antlr.NewCommonTokenStream(lexer, 0)
+	stream := antlr.NewCommonTokenStream(parser.NewKeywordTokenSource(lexer), 0)
 	p := parser.NewapexParser(stream)
 	// p.AddErrorListener(antlr.NewDiagnosticErrorListener(true))
 	p.BuildParseTrees = true
@@ -42,3 +45,34 @@ func parse(input antlr.CharStream, src string) Node {
 	})
 	return t.(Node)
 }
+
+// syntaxErrorListener collects the syntax errors instead of printing them
+type syntaxErrorListener struct {
+	*antlr.DefaultErrorListener
+	errors []string
+}
+
+func (l *syntaxErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	l.errors = append(l.errors, fmt.Sprintf("line %d:%d %s", line, column, msg))
+}
+
+// ParseQuery parses the SOQL query given as a string, such as the argument of Database.getQueryLocator
+func ParseQuery(src string) (*Soql, error) {
+	listener := &syntaxErrorListener{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+	lexer := parser.NewapexLexer(antlr.NewInputStream(src))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(listener)
+	stream := antlr.NewCommonTokenStream(parser.NewKeywordTokenSource(lexer), 0)
+	p := parser.NewapexParser(stream)
+	p.RemoveErrorListeners()
+	p.AddErrorListener(listener)
+	p.BuildParseTrees = true
+	tree := p.Query()
+	if len(listener.errors) > 0 {
+		return nil, errors.New(strings.Join(listener.errors, "\n"))
+	}
+	if token := stream.LT(1); token.GetTokenType() != antlr.TokenEOF {
+		return nil, fmt.Errorf("unexpected token: '%s'", token.GetText())
+	}
+	return tree.Accept(&Builder{Source: "<query>"}).(*Soql), nil
+}
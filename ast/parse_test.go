@@ -801,8 +801,10 @@ public void action(){
 									Value: []string{"C__c"},
 								},
 								Op: "=",
-								Expression: &Name{
-									Value: []string{"foo"},
+								Expression: &SoqlBindVariable{
+									Expression: &Name{
+										Value: []string{"foo"},
+									},
 								},
 								Not: false,
 							},
@@ -813,15 +815,28 @@ public void action(){
 								Value: []string{"D__c"},
 							},
 							Op: "=",
-							Expression: &MethodInvocation{
-								NameOrExpression: &Name{
-									Value: []string{"bar"},
+							Expression: &SoqlBindVariable{
+								Expression: &MethodInvocation{
+									NameOrExpression: &Name{
+										Value: []string{"bar"},
+									},
 								},
 							},
 							Not: false,
 						},
 						Op: "OR",
 					},
+					Group: &Group{
+						Fields: []Node{
+							&SelectField{Value: []string{"E__c"}},
+							&SelectField{Value: []string{"F__c"}},
+						},
+					},
+					Order: []*Order{
+						{Field: &SelectField{Value: []string{"id"}}, Asc: true},
+						{Field: &SelectField{Value: []string{"Name"}}, Asc: true},
+					},
+					Offset: &IntegerLiteral{Value: 1000},
 				},
 			}),
 		},
@@ -851,6 +866,79 @@ true;
 				},
 			},
 		},
+		{
+			`class Foo {
+public void action(){
+records = Trigger.new;
+records = Trigger.old;
+}
+}`,
+			createExpectedClass([]Node{
+				&BinaryOperator{
+					Op: "=",
+					Left: &Name{
+						Value: []string{"records"},
+					},
+					Right: &Name{
+						Value: []string{"Trigger", "new"},
+					},
+				},
+				&BinaryOperator{
+					Op: "=",
+					Left: &Name{
+						Value: []string{"records"},
+					},
+					Right: &Name{
+						Value: []string{"Trigger", "old"},
+					},
+				},
+			}),
+		},
+		{
+			`public enum Season { WINTER, SPRING, SUMMER, FALL }`,
+			&EnumDeclaration{
+				Modifiers: []*Modifier{
+					{
+						Name: "public",
+					},
+				},
+				Annotations: []*Annotation{},
+				Name:        "Season",
+				Identifiers: []string{"WINTER", "SPRING", "SUMMER", "FALL"},
+			},
+		},
+		{
+			`class Foo {
+public void action(){
+[FIND :term IN NAME FIELDS RETURNING Account(Id, Owner.Name WHERE Type = 'Customer' LIMIT 10), Contact];
+}
+}`,
+			createExpectedClass([]Node{
+				&Sosl{
+					SearchTerm:  &SoqlBindVariable{Expression: &Name{Value: []string{"term"}}},
+					SearchGroup: "NAME",
+					Returning: []*Soql{
+						{
+							SelectFields: []Node{
+								&SelectField{Value: []string{"Id"}},
+								&SelectField{Value: []string{"Owner", "Name"}},
+							},
+							FromObject: "Account",
+							Where: &WhereCondition{
+								Field:      &SelectField{Value: []string{"Type"}},
+								Op:         "=",
+								Expression: &StringLiteral{Value: "Customer"},
+							},
+							Limit: &IntegerLiteral{Value: 10},
+						},
+						{
+							SelectFields: []Node{&SelectField{Value: []string{"Id"}}},
+							FromObject:   "Contact",
+						},
+					},
+				},
+			}),
+		},
 	}
 	for _, testCase := range testCases {
 		actual, err := ParseString(testCase.Code)
@@ -895,3 +983,107 @@ func createExpectedClass(statements []Node) *ClassDeclaration {
 		},
 	}
 }
+
+func TestParseQuery(t *testing.T) {
+	actual, err := ParseQuery("SELECT Id, Name FROM Account WHERE Name = 'foo'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &Soql{
+		SelectFields: []Node{
+			&SelectField{Value: []string{"Id"}},
+			&SelectField{Value: []string{"Name"}},
+		},
+		FromObject: "Account",
+		Where: &WhereCondition{
+			Field:      &SelectField{Value: []string{"Name"}},
+			Op:         "=",
+			Expression: &StringLiteral{Value: "foo"},
+		},
+	}
+	equalNode(t, expected, actual)
+
+	actual, err = ParseQuery("SELECT Id FROM Account ORDER BY Name DESC NULLS LAST, CreatedDate, Owner.Name ASC NULLS FIRST LIMIT :size OFFSET 10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = &Soql{
+		SelectFields: []Node{
+			&SelectField{Value: []string{"Id"}},
+		},
+		FromObject: "Account",
+		Order: []*Order{
+			{Field: &SelectField{Value: []string{"Name"}}, Asc: false, Nulls: "LAST"},
+			{Field: &SelectField{Value: []string{"CreatedDate"}}, Asc: true},
+			{Field: &SelectField{Value: []string{"Owner", "Name"}}, Asc: true, Nulls: "FIRST"},
+		},
+		Limit:  &SoqlBindVariable{Expression: &Name{Value: []string{"size"}}},
+		Offset: &IntegerLiteral{Value: 10},
+	}
+	equalNode(t, expected, actual)
+
+	actual, err = ParseQuery("SELECT StageName, SUM(Amount) total, COUNT(Id) FROM Opportunity GROUP BY ROLLUP(StageName, LeadSource) HAVING COUNT(Id) > 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = &Soql{
+		SelectFields: []Node{
+			&SelectField{Value: []string{"StageName"}},
+			&SoqlFunction{Name: "SUM", Fields: []Node{&SelectField{Value: []string{"Amount"}}}, Alias: "total"},
+			&SoqlFunction{Name: "COUNT", Fields: []Node{&SelectField{Value: []string{"Id"}}}},
+		},
+		FromObject: "Opportunity",
+		Group: &Group{
+			Fields: []Node{
+				&SoqlFunction{Name: "ROLLUP", Fields: []Node{
+					&SelectField{Value: []string{"StageName"}},
+					&SelectField{Value: []string{"LeadSource"}},
+				}},
+			},
+			Having: &WhereCondition{
+				Field:      &SoqlFunction{Name: "COUNT", Fields: []Node{&SelectField{Value: []string{"Id"}}}},
+				Op:         ">",
+				Expression: &IntegerLiteral{Value: 1},
+			},
+		},
+	}
+	equalNode(t, expected, actual)
+
+	actual, err = ParseQuery("SELECT Id, Owner.Manager.Name, (SELECT LastName FROM Contacts WHERE LastName != null LIMIT 1) FROM Account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = &Soql{
+		SelectFields: []Node{
+			&SelectField{Value: []string{"Id"}},
+			&SelectField{Value: []string{"Owner", "Manager", "Name"}},
+			&Soql{
+				SelectFields: []Node{
+					&SelectField{Value: []string{"LastName"}},
+				},
+				FromObject: "Contacts",
+				Where: &WhereCondition{
+					Field:      &SelectField{Value: []string{"LastName"}},
+					Op:         "!=",
+					Expression: &NullLiteral{},
+				},
+				Limit: &IntegerLiteral{Value: 1},
+			},
+		},
+		FromObject: "Account",
+	}
+	equalNode(t, expected, actual)
+
+	for _, query := range []string{
+		"SELECT FROM Account",
+		"SELECT Id, (SELECT Id FROM Contacts FROM Account",
+		"SELECT Id FROM Account ORDER BY Name NULLS",
+		"SELECT Id FROM Account LIMIT",
+		"SELECT Id FROM Account foo",
+		"SELECT COUNT(Id) FROM Account GROUP BY ROLLUP(Name",
+	} {
+		if _, err := ParseQuery(query); err == nil {
+			t.Errorf("expected syntax error: %s", query)
+		}
+	}
+}
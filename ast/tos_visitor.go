@@ -129,6 +129,36 @@ func (v *TosVisitor) VisitInterfaceDeclaration(n *InterfaceDeclaration) (interfa
 	), nil
 }
 
+func (v *TosVisitor) VisitEnumDeclaration(n *EnumDeclaration) (interface{}, error) {
+	modifiers := make([]string, len(n.Modifiers))
+	for i, m := range n.Modifiers {
+		r, err := m.Accept(v)
+		if err != nil {
+			return nil, err
+		}
+		modifiers[i] = r.(string)
+	}
+	identifiers := make([]string, len(n.Identifiers))
+	v.AddIndent(func() {
+		for i, identifier := range n.Identifiers {
+			identifiers[i] = v.withIndent(identifier)
+		}
+	})
+	body := ""
+	if len(identifiers) != 0 {
+		body = fmt.Sprintf("%s\n", strings.Join(identifiers, ",\n"))
+	}
+
+	return fmt.Sprintf(
+		`%s enum %s {
+%s%s`,
+		strings.Join(modifiers, " "),
+		n.Name,
+		body,
+		v.withIndent("}"),
+	), nil
+}
+
 func (v *TosVisitor) VisitIntegerLiteral(n *IntegerLiteral) (interface{}, error) {
 	return fmt.Sprintf("%d", n.Value), nil
 }
@@ -581,14 +611,30 @@ func (v *TosVisitor) VisitSoql(n *Soql) (interface{}, error) {
 	where := ""
 	fields := make([]string, len(n.SelectFields))
 	from := ""
+	groups := []string{}
+	having := ""
+	orders := make([]string, len(n.Order))
 	v.AddIndent(func() {
 		v.AddIndent(func() {
 			for i, f := range n.SelectFields {
+				fields[i] = v.withIndent(soqlFieldString(f))
 				switch val := f.(type) {
 				case *SelectField:
-					fields[i] = v.withIndent(strings.Join(val.Value, "."))
+					if val.Alias != "" {
+						fields[i] += " " + val.Alias
+					}
 				case *SoqlFunction:
-					fields[i] = v.withIndent(val.Name + "()")
+					if val.Alias != "" {
+						fields[i] += " " + val.Alias
+					}
+				case *Soql:
+					// the subquery is enclosed in the parentheses instead of the brackets
+					subquery, err := v.VisitSoql(val)
+					if err != nil {
+						panic(err)
+					}
+					str := subquery.(string)
+					fields[i] = v.withIndent("(" + str[1:len(str)-1] + ")")
 				}
 			}
 
@@ -597,6 +643,23 @@ func (v *TosVisitor) VisitSoql(n *Soql) (interface{}, error) {
 			if n.Where != nil {
 				where = v.withIndent(v.createWhere(n.Where))
 			}
+			if n.Group != nil {
+				for _, f := range n.Group.Fields {
+					groups = append(groups, v.withIndent(soqlFieldString(f)))
+				}
+				if n.Group.Having != nil {
+					having = v.withIndent(v.createWhere(n.Group.Having))
+				}
+			}
+			for i, order := range n.Order {
+				orders[i] = v.withIndent(soqlFieldString(order.Field))
+				if !order.Asc {
+					orders[i] += " DESC"
+				}
+				if order.Nulls != "" {
+					orders[i] += " NULLS " + order.Nulls
+				}
+			}
 		})
 	})
 
@@ -607,8 +670,17 @@ func (v *TosVisitor) VisitSoql(n *Soql) (interface{}, error) {
 	if where != "" {
 		where = "\n" + indent + "WHERE\n" + where
 	}
-	orderBy := ""
 	groupBy := ""
+	if len(groups) > 0 {
+		groupBy = "\n" + indent + "GROUP BY\n" + strings.Join(groups, ",\n")
+	}
+	if having != "" {
+		groupBy += "\n" + indent + "HAVING\n" + having
+	}
+	orderBy := ""
+	if len(orders) > 0 {
+		orderBy = "\n" + indent + "ORDER BY\n" + strings.Join(orders, ",\n")
+	}
 	limit := ""
 	if n.Limit != nil {
 		i, err := n.Limit.Accept(v)
@@ -621,39 +693,60 @@ func (v *TosVisitor) VisitSoql(n *Soql) (interface{}, error) {
 			})
 		})
 	}
+	offset := ""
+	if n.Offset != nil {
+		i, err := n.Offset.Accept(v)
+		if err != nil {
+			return nil, err
+		}
+		v.AddIndent(func() {
+			v.AddIndent(func() {
+				offset = "\n" + indent + "OFFSET\n" + v.withIndent(i.(string))
+			})
+		})
+	}
 
 	return fmt.Sprintf(`[
 %sSELECT
 %s
 %sFROM
-%s%s%s%s%s%s`,
+%s%s%s%s%s%s%s`,
 		indent,
 		strings.Join(fields, ",\n"),
 		indent,
 		from,
 		where,
-		orderBy,
 		groupBy,
+		orderBy,
 		limit,
+		offset,
 		"\n"+v.withIndent("]"),
 	), nil
 }
 
+// soqlFieldString returns the field path or the function call of SOQL, such as Account.Name and COUNT(Id)
+func soqlFieldString(n Node) string {
+	switch f := n.(type) {
+	case *SelectField:
+		return strings.Join(f.Value, ".")
+	case *SoqlFunction:
+		fields := make([]string, len(f.Fields))
+		for i, field := range f.Fields {
+			fields[i] = soqlFieldString(field)
+		}
+		return f.Name + "(" + strings.Join(fields, ", ") + ")"
+	}
+	return ""
+}
+
 func (v *TosVisitor) createWhere(n Node) string {
 	switch val := n.(type) {
 	case *WhereCondition:
-		var field string
-		switch f := val.Field.(type) {
-		case *SelectField:
-			field = strings.Join(f.Value, ".")
-		case *SoqlFunction:
-			field = f.Name + "()"
-		}
 		value, err := val.Expression.Accept(v)
 		if err != nil {
 			panic(err)
 		}
-		return fmt.Sprintf("%s %s %s", field, val.Op, value.(string))
+		return fmt.Sprintf("%s %s %s", soqlFieldString(val.Field), val.Op, value.(string))
 	case *WhereBinaryOperator:
 		where := ""
 		if val.Left != nil {
@@ -668,7 +761,49 @@ func (v *TosVisitor) createWhere(n Node) string {
 }
 
 func (v *TosVisitor) VisitSosl(n *Sosl) (interface{}, error) {
-	return VisitSosl(v, n)
+	term, err := n.SearchTerm.Accept(v)
+	if err != nil {
+		return nil, err
+	}
+	returning := make([]string, len(n.Returning))
+	v.AddIndent(func() {
+		v.AddIndent(func() {
+			for i, soql := range n.Returning {
+				fields := make([]string, len(soql.SelectFields))
+				for j, f := range soql.SelectFields {
+					fields[j] = soqlFieldString(f)
+				}
+				returning[i] = fmt.Sprintf("%s(%s", soql.FromObject, strings.Join(fields, ", "))
+				if soql.Where != nil {
+					returning[i] += " WHERE " + v.createWhere(soql.Where)
+				}
+				if soql.Limit != nil {
+					limit, err := soql.Limit.Accept(v)
+					if err != nil {
+						panic(err)
+					}
+					returning[i] += " LIMIT " + limit.(string)
+				}
+				returning[i] = v.withIndent(returning[i] + ")")
+			}
+		})
+	})
+	indent := ""
+	v.AddIndent(func() {
+		indent = v.withIndent("")
+	})
+	if len(returning) == 0 {
+		return fmt.Sprintf("[\n%sFIND %s IN %s FIELDS\n%s", indent, term.(string), n.SearchGroup, v.withIndent("]")), nil
+	}
+	return fmt.Sprintf(
+		"[\n%sFIND %s IN %s FIELDS\n%sRETURNING\n%s\n%s",
+		indent,
+		term.(string),
+		n.SearchGroup,
+		indent,
+		strings.Join(returning, ",\n"),
+		v.withIndent("]"),
+	), nil
 }
 
 func (v *TosVisitor) VisitStringLiteral(n *StringLiteral) (interface{}, error) {
@@ -916,7 +1051,11 @@ func (v *TosVisitor) VisitArrayCreator(n *ArrayCreator) (interface{}, error) {
 }
 
 func (v *TosVisitor) VisitSoqlBindVariable(n *SoqlBindVariable) (interface{}, error) {
-	return VisitSoqlBindVariable(v, n)
+	exp, err := n.Expression.Accept(v)
+	if err != nil {
+		return nil, err
+	}
+	return ":" + exp.(string), nil
 }
 
 func (v *TosVisitor) VisitTernalyExpression(n *TernalyExpression) (interface{}, error) {
@@ -0,0 +1,175 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// aggregateFunctions are the aggregate functions of SOQL and the types of their values,
+// nil is the type of the field such as MIN and MAX
+var aggregateFunctions = map[string]*ast.ClassType{
+	"count":          IntegerType,
+	"count_distinct": IntegerType,
+	"sum":            DoubleType,
+	"avg":            DoubleType,
+	"min":            nil,
+	"max":            nil,
+	"grouping":       IntegerType,
+}
+
+// AggregateFunctionType returns the type of the value of the aggregate function on the field type
+func AggregateFunctionType(name string, fieldType *ast.ClassType) (*ast.ClassType, bool) {
+	t, ok := aggregateFunctions[strings.ToLower(name)]
+	if !ok {
+		return nil, false
+	}
+	if t == nil {
+		return fieldType, true
+	}
+	return t, true
+}
+
+// IsCountQuery returns whether the query is SELECT COUNT() FROM ..., which returns the number of the records as Integer
+func IsCountQuery(n *ast.Soql) bool {
+	if len(n.SelectFields) != 1 || n.Group != nil {
+		return false
+	}
+	f, ok := n.SelectFields[0].(*ast.SoqlFunction)
+	return ok && strings.EqualFold(f.Name, "count") && len(f.Fields) == 0
+}
+
+// AggregateFieldNames returns the names of the values of AggregateResult in order of the select fields,
+// which are the aliases, the field names, or expr0, expr1... for the functions without the aliases
+func AggregateFieldNames(n *ast.Soql) []string {
+	names := make([]string, len(n.SelectFields))
+	expressions := 0
+	for i, field := range n.SelectFields {
+		switch f := field.(type) {
+		case *ast.SelectField:
+			names[i] = f.Alias
+			if names[i] == "" {
+				names[i] = f.Value[len(f.Value)-1]
+			}
+		case *ast.SoqlFunction:
+			names[i] = f.Alias
+			if names[i] == "" {
+				names[i] = fmt.Sprintf("expr%d", expressions)
+				expressions++
+			}
+		}
+	}
+	return names
+}
+
+// groupingSets returns the fields of GROUP BY and the sets of the fields which the records are grouped by.
+// GROUP BY ROLLUP(a, b) is grouped by (a, b), (a) and (), and GROUP BY CUBE(a, b) by (a, b), (a), (b) and (),
+// the fields out of the set are the subtotals whose values are null.
+func groupingSets(group *ast.Group) ([]ast.Node, [][]ast.Node) {
+	if len(group.Fields) == 1 {
+		if f, ok := group.Fields[0].(*ast.SoqlFunction); ok {
+			switch strings.ToLower(f.Name) {
+			case "rollup":
+				sets := [][]ast.Node{}
+				for i := len(f.Fields); i >= 0; i-- {
+					sets = append(sets, f.Fields[:i])
+				}
+				return f.Fields, sets
+			case "cube":
+				sets := [][]ast.Node{}
+				for size := len(f.Fields); size >= 0; size-- {
+					sets = append(sets, combinations(f.Fields, size)...)
+				}
+				return f.Fields, sets
+			}
+		}
+	}
+	return group.Fields, [][]ast.Node{group.Fields}
+}
+
+// IsGroupingSets returns whether the query is grouped by ROLLUP or CUBE
+func IsGroupingSets(group *ast.Group) bool {
+	if group == nil {
+		return false
+	}
+	_, sets := groupingSets(group)
+	return len(sets) > 1
+}
+
+// GroupingSetsOrderError returns the error of the field of ORDER BY which is not selected in the query grouped by ROLLUP or CUBE,
+// as the subtotals are sorted by the select fields. nil is returned if the query is not grouped by them.
+func GroupingSetsOrderError(n *ast.Soql) error {
+	if !IsGroupingSets(n.Group) {
+		return nil
+	}
+	for _, order := range n.Order {
+		if containsField(n.SelectFields, order.Field) {
+			continue
+		}
+		name := order.Field.GetType()
+		switch f := order.Field.(type) {
+		case *ast.SelectField:
+			name = strings.Join(f.Value, ".")
+		case *ast.SoqlFunction:
+			name = f.Name + "()"
+		}
+		return fmt.Errorf("ORDER BY field must be selected with ROLLUP or CUBE: %s", name)
+	}
+	return nil
+}
+
+// combinations returns the combinations of the size of the fields in order of the fields
+func combinations(fields []ast.Node, size int) [][]ast.Node {
+	if size == 0 {
+		return [][]ast.Node{{}}
+	}
+	results := [][]ast.Node{}
+	for i := 0; i <= len(fields)-size; i++ {
+		for _, rest := range combinations(fields[i+1:], size-1) {
+			results = append(results, append([]ast.Node{fields[i]}, rest...))
+		}
+	}
+	return results
+}
+
+// sameField returns whether the field paths or the function calls are the same, which are compared case insensitively
+func sameField(a, b ast.Node) bool {
+	switch x := a.(type) {
+	case *ast.SelectField:
+		y, ok := b.(*ast.SelectField)
+		return ok && strings.EqualFold(strings.Join(x.Value, "."), strings.Join(y.Value, "."))
+	case *ast.SoqlFunction:
+		y, ok := b.(*ast.SoqlFunction)
+		if !ok || !strings.EqualFold(x.Name, y.Name) || len(x.Fields) != len(y.Fields) {
+			return false
+		}
+		for i, field := range x.Fields {
+			if !sameField(field, y.Fields[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func containsField(fields []ast.Node, field ast.Node) bool {
+	for _, f := range fields {
+		if sameField(f, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// rolledUpFields returns the fields of GROUP BY out of the grouping set, whose values are null in the subtotals
+func rolledUpFields(fields []ast.Node, set []ast.Node) []ast.Node {
+	rolledUp := []ast.Node{}
+	for _, field := range fields {
+		if !containsField(set, field) {
+			rolledUp = append(rolledUp, field)
+		}
+	}
+	return rolledUp
+}
@@ -57,7 +57,7 @@ var messageTypeParameter = &ast.Parameter{
 	Type: messageType,
 }
 
-var severityType = ast.CreateEnum("Severity", []string{"CONFIRM", "ERROR", "FATAL", "INFO", "WARNING"})
+var severityType = CreateEnumType("Severity", []string{"CONFIRM", "ERROR", "FATAL", "INFO", "WARNING"})
 
 var severityTypeParameter = &ast.Parameter{
 	Name: "_",
@@ -0,0 +1,275 @@
+package builtin
+
+import (
+	"github.com/tzmfreedom/land/ast"
+)
+
+const (
+	AsyncJobTypeFuture    = "Future"
+	AsyncJobTypeQueueable = "Queueable"
+	AsyncJobTypeBatch     = "BatchApex"
+	AsyncJobTypeScheduled = "ScheduledApex"
+)
+
+const (
+	AsyncJobStatusQueued     = "Queued"
+	AsyncJobStatusProcessing = "Processing"
+	AsyncJobStatusCompleted  = "Completed"
+	AsyncJobStatusFailed     = "Failed"
+	AsyncJobStatusAborted    = "Aborted"
+)
+
+// AsyncExecutor queues the asynchronous jobs and reports the kind of the running job, which is implemented by interpreter
+type AsyncExecutor interface {
+	EnqueueJob(queueable *ast.Object) (string, error)
+	ExecuteBatch(batchable *ast.Object, scope int) (string, error)
+	IsFuture() bool
+	IsQueueable() bool
+	IsBatch() bool
+	IsScheduled() bool
+	CalloutAllowed() bool
+}
+
+// AsyncApexJob is the record of an asynchronous job, which is saved to the table of AsyncApexJob to be queried by SOQL
+type AsyncApexJob struct {
+	Id                string
+	JobType           string
+	Status            string
+	ExtendedStatus    string
+	MethodName        string
+	ParentJobId       string
+	NumberOfErrors    int
+	JobItemsProcessed int
+	TotalJobItems     int
+}
+
+var asyncApexJobSObject = Sobject{
+	Name:      "AsyncApexJob",
+	Label:     "Apex Job",
+	KeyPrefix: "707",
+	Fields: []SobjectField{
+		{Name: "Id", Type: "id"},
+		{Name: "JobType", Type: "picklist"},
+		{Name: "Status", Type: "picklist"},
+		{Name: "ExtendedStatus", Type: "string"},
+		{Name: "MethodName", Type: "string"},
+		{Name: "ParentJobId", Type: "reference", ReferenceTo: []string{"AsyncApexJob"}},
+		{Name: "NumberOfErrors", Type: "int"},
+		{Name: "JobItemsProcessed", Type: "int"},
+		{Name: "TotalJobItems", Type: "int"},
+	},
+}
+
+func (j *AsyncApexJob) values() []interface{} {
+	return []interface{}{
+		j.Id,
+		j.JobType,
+		j.Status,
+		j.ExtendedStatus,
+		j.MethodName,
+		j.ParentJobId,
+		j.NumberOfErrors,
+		j.JobItemsProcessed,
+		j.TotalJobItems,
+	}
+}
+
+// Insert saves the queued job
+func (j *AsyncApexJob) Insert() error {
+	return insertStandardRecord(asyncApexJobSObject, j.values())
+}
+
+// insertStandardRecord saves the record of the standard sObject provided by land,
+// the table is created on demand as the metafile does not declare it
+func insertStandardRecord(sobject Sobject, values []interface{}) error {
+	if err := DatabaseDriver.CreateTable(sobject.Name, sobject); err != nil {
+		return err
+	}
+	fields := map[string]interface{}{}
+	for i, field := range sobject.Fields {
+		fields[field.Name] = values[i]
+	}
+	return DatabaseDriver.Insert(sobject.Name, fields)
+}
+
+// Update saves the status and the progress of the job
+func (j *AsyncApexJob) Update() error {
+	return DatabaseDriver.Update(asyncApexJobSObject.Name, j.Id, map[string]interface{}{
+		"Status":            j.Status,
+		"ExtendedStatus":    j.ExtendedStatus,
+		"NumberOfErrors":    j.NumberOfErrors,
+		"JobItemsProcessed": j.JobItemsProcessed,
+		"TotalJobItems":     j.TotalJobItems,
+	})
+}
+
+// Fail records the exception thrown by the job
+func (j *AsyncApexJob) Fail(exception *ast.Object) {
+	j.Status = AsyncJobStatusFailed
+	j.NumberOfErrors++
+	j.ExtendedStatus = exceptionSummary(exception)
+}
+
+// AddError records the exception thrown by a chunk of the batch job, which does not stop the other chunks
+func (j *AsyncApexJob) AddError(exception *ast.Object) {
+	if j.NumberOfErrors == 0 {
+		j.ExtendedStatus = "First error: " + exceptionSummary(exception)
+	}
+	j.NumberOfErrors++
+}
+
+func exceptionSummary(exception *ast.Object) string {
+	summary := ExceptionTypeName(exception.ClassType)
+	if message := exceptionExtra(exception, "message"); message != Null {
+		summary += ": " + message.StringValue()
+	}
+	return summary
+}
+
+var QueueableType = ast.CreateClass(
+	"Queueable",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var queueableContextType = ast.CreateClass(
+	"QueueableContext",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+// NewQueueableContext creates the context passed to Queueable#execute
+func NewQueueableContext(jobId string) *ast.Object {
+	obj := ast.CreateObject(queueableContextType)
+	obj.Extra["jobId"] = jobId
+	return obj
+}
+
+// setAsyncMethods sets the methods of System for the asynchronous jobs
+func setAsyncMethods(staticMethods *ast.MethodMap) {
+	staticMethods.Set(
+		"enqueueJob",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"enqueueJob",
+				StringType,
+				[]*ast.Parameter{{Type: QueueableType, Name: "_"}},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return CreateRaise(NewException(NullPointerExceptionType, "Argument cannot be null"))
+					}
+					jobId, err := extra["interpreter"].(AsyncExecutor).EnqueueJob(params[0])
+					if err != nil {
+						if raise, ok := err.(*RaiseError); ok {
+							return CreateRaise(raise.Exception)
+						}
+						panic(err)
+					}
+					return NewString(jobId)
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"isFuture",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"isFuture",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(extra["interpreter"].(AsyncExecutor).IsFuture())
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"isBatch",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"isBatch",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(extra["interpreter"].(AsyncExecutor).IsBatch())
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"isScheduled",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"isScheduled",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(extra["interpreter"].(AsyncExecutor).IsScheduled())
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"isQueueable",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"isQueueable",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(extra["interpreter"].(AsyncExecutor).IsQueueable())
+				},
+			),
+		},
+	)
+}
+
+// checkCalloutAllowed returns CalloutException if the running future method is not annotated with callout=true,
+// the running batch does not implement Database.AllowsCallouts, or the running job is scheduled Apex
+func checkCalloutAllowed(extra map[string]interface{}) *ast.Object {
+	executor := extra["interpreter"].(AsyncExecutor)
+	if executor.CalloutAllowed() {
+		return nil
+	}
+	if executor.IsBatch() {
+		return NewException(CalloutExceptionType, "Callout not allowed from this batch. Please implement Database.AllowsCallouts.")
+	}
+	if executor.IsScheduled() {
+		return NewException(CalloutExceptionType, "Callout from scheduled Apex not supported.")
+	}
+	return NewException(CalloutExceptionType, "Callout not allowed from this future method. Please enable callout=true for the future method.")
+}
+
+func init() {
+	QueueableType.Interface = true
+	QueueableType.InstanceMethods.Set(
+		"execute",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"execute",
+				nil,
+				[]*ast.Parameter{{Type: queueableContextType, Name: "_"}},
+				nil,
+			),
+		},
+	)
+
+	queueableContextType.InstanceMethods.Set(
+		"getJobId",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getJobId",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["jobId"].(string))
+				},
+			),
+		},
+	)
+
+	primitiveClassMap.Set("Queueable", QueueableType)
+	primitiveClassMap.Set("QueueableContext", queueableContextType)
+}
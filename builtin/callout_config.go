@@ -0,0 +1,91 @@
+package builtin
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const DefaultCalloutConfigName = "callouts.yml"
+
+// CalloutConfig is the local replacement of the named credentials and the certificates of the org
+//
+//	named_credentials:
+//	  Foo:
+//	    endpoint: https://api.example.com
+//	    headers:
+//	      Authorization: Bearer xxx
+//	certificates:
+//	  Bar:
+//	    cert_file: certs/bar.crt
+//	    key_file: certs/bar.key
+type CalloutConfig struct {
+	NamedCredentials map[string]*NamedCredential `yaml:"named_credentials"`
+	Certificates     map[string]*Certificate     `yaml:"certificates"`
+}
+
+type NamedCredential struct {
+	Endpoint string            `yaml:"endpoint"`
+	Headers  map[string]string `yaml:"headers"`
+}
+
+type Certificate struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+}
+
+var calloutConfig = &CalloutConfig{}
+
+// LoadCalloutConfig loads the config file, which is optional
+func LoadCalloutConfig(src string) error {
+	body, err := ioutil.ReadFile(src)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	config := &CalloutConfig{}
+	if err := yaml.Unmarshal(body, config); err != nil {
+		return err
+	}
+	calloutConfig = config
+	return nil
+}
+
+// ResolveEndpoint converts the endpoint of named credential such as `callout:Foo/path`
+// to the url and the headers added to the request
+func ResolveEndpoint(endpoint string) (string, map[string]string, error) {
+	if !strings.HasPrefix(endpoint, "callout:") {
+		return endpoint, map[string]string{}, nil
+	}
+	name := strings.TrimPrefix(endpoint, "callout:")
+	path := ""
+	if i := strings.IndexAny(name, "/?"); i >= 0 {
+		name, path = name[:i], name[i:]
+	}
+	for credentialName, credential := range calloutConfig.NamedCredentials {
+		if strings.EqualFold(credentialName, name) {
+			headers := credential.Headers
+			if headers == nil {
+				headers = map[string]string{}
+			}
+			return strings.TrimSuffix(credential.Endpoint, "/") + path, headers, nil
+		}
+	}
+	return "", nil, fmt.Errorf("Named credential not found: %s", name)
+}
+
+// LoadCertificate returns the client certificate for HttpRequest#setClientCertificateName
+func LoadCertificate(name string) (tls.Certificate, error) {
+	for certificateName, certificate := range calloutConfig.Certificates {
+		if strings.EqualFold(certificateName, name) {
+			return tls.LoadX509KeyPair(certificate.CertFile, certificate.KeyFile)
+		}
+	}
+	return tls.Certificate{}, fmt.Errorf("Certificate not found: %s", name)
+}
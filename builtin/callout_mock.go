@@ -0,0 +1,308 @@
+package builtin
+
+import (
+	"fmt"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+const calloutInTestMessage = "Methods defined as TestMethod do not support Web service callouts"
+
+var HttpCalloutMockType = ast.CreateClass(
+	"HttpCalloutMock",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var WebServiceMockType = ast.CreateClass(
+	"WebServiceMock",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+// setMock registers the mock for the callouts in test, which is used instead of the real endpoint
+func setMock(mockType *ast.ClassType, mock *ast.Object, extra map[string]interface{}) interface{} {
+	switch mockType {
+	case HttpCalloutMockType:
+		extra["http_callout_mock"] = mock
+	case WebServiceMockType:
+		extra["web_service_mock"] = mock
+	default:
+		return CreateRaise(NewException(TypeExceptionType, fmt.Sprintf("Invalid mock type: %s", mockType.String())))
+	}
+	return nil
+}
+
+// invokeMock calls the method of the mock, exceptions thrown by the mock are raised from the callout
+func invokeMock(mock *ast.Object, methodName string, params []*ast.Object, extra map[string]interface{}) interface{} {
+	invoker := extra["interpreter"].(MethodInvoker)
+	r, err := invoker.InvokeMethod(mock, methodName, params)
+	if err != nil {
+		if raise, ok := err.(*RaiseError); ok {
+			return CreateRaise(raise.Exception)
+		}
+		panic(err)
+	}
+	return r
+}
+
+// staticResourceResponse creates the response of StaticResourceCalloutMock whose body is the static resource
+func staticResourceResponse(mock *ast.Object, resourceName string) interface{} {
+	body, err := ReadStaticResource(resourceName)
+	if err != nil {
+		return CreateRaise(NewException(CalloutExceptionType, err.Error()))
+	}
+	response := newHttpResponse()
+	response.Extra["body"] = body
+	response.Extra["statusCode"] = mock.Extra["statusCode"]
+	response.Extra["status"] = mock.Extra["status"]
+	headers := response.Extra["headers"].(map[string]string)
+	for key, value := range mock.Extra["headers"].(map[string]string) {
+		headers[key] = value
+	}
+	return response
+}
+
+// createStaticResourceCalloutMockType creates the class of StaticResourceCalloutMock and MultiStaticResourceCalloutMock.
+// They have the same setters for the response except setStaticResource.
+func createStaticResourceCalloutMockType(name string) *ast.ClassType {
+	instanceMethods := ast.NewMethodMap()
+	classType := ast.CreateClass(
+		name,
+		[]*ast.Method{
+			ast.CreateMethod(
+				name,
+				nil,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["resources"] = map[string]string{}
+					this.Extra["headers"] = map[string]string{}
+					this.Extra["statusCode"] = 200
+					this.Extra["status"] = "OK"
+					return nil
+				},
+			),
+		},
+		instanceMethods,
+		ast.NewMethodMap(),
+	)
+	classType.ImplementClasses = []*ast.ClassType{HttpCalloutMockType}
+
+	instanceMethods.Set(
+		"setStatusCode",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStatusCode",
+				nil,
+				[]*ast.Parameter{IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["statusCode"] = params[0].IntegerValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setStatus",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStatus",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["status"] = params[0].StringValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setHeader",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setHeader",
+				nil,
+				[]*ast.Parameter{stringTypeParameter, stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					headers := this.Extra["headers"].(map[string]string)
+					headers[params[0].StringValue()] = params[1].StringValue()
+					return nil
+				},
+			),
+		},
+	)
+	return classType
+}
+
+func init() {
+	HttpCalloutMockType.Interface = true
+	HttpCalloutMockType.InstanceMethods.Set(
+		"respond",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"respond",
+				httpResponseType,
+				[]*ast.Parameter{httpRequestTypeParameter},
+				nil,
+			),
+		},
+	)
+
+	WebServiceMockType.Interface = true
+	WebServiceMockType.InstanceMethods.Set(
+		"doInvoke",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"doInvoke",
+				nil,
+				[]*ast.Parameter{
+					objectTypeParameter,
+					objectTypeParameter,
+					{Type: CreateMapType(StringType, ObjectType), Name: "_"},
+					stringTypeParameter,
+					stringTypeParameter,
+					stringTypeParameter,
+					stringTypeParameter,
+					stringTypeParameter,
+					stringTypeParameter,
+				},
+				nil,
+			),
+		},
+	)
+
+	staticResourceCalloutMockType := createStaticResourceCalloutMockType("StaticResourceCalloutMock")
+	staticResourceCalloutMockType.InstanceMethods.Set(
+		"setStaticResource",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStaticResource",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["resource"] = params[0].StringValue()
+					return nil
+				},
+			),
+		},
+	)
+	staticResourceCalloutMockType.InstanceMethods.Set(
+		"respond",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"respond",
+				httpResponseType,
+				[]*ast.Parameter{httpRequestTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					resource, ok := this.Extra["resource"].(string)
+					if !ok {
+						return CreateRaise(NewException(CalloutExceptionType, "Static resource is not set"))
+					}
+					return staticResourceResponse(this, resource)
+				},
+			),
+		},
+	)
+
+	multiStaticResourceCalloutMockType := createStaticResourceCalloutMockType("MultiStaticResourceCalloutMock")
+	multiStaticResourceCalloutMockType.InstanceMethods.Set(
+		"setStaticResource",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStaticResource",
+				nil,
+				[]*ast.Parameter{stringTypeParameter, stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					resources := this.Extra["resources"].(map[string]string)
+					resources[params[0].StringValue()] = params[1].StringValue()
+					return nil
+				},
+			),
+		},
+	)
+	multiStaticResourceCalloutMockType.InstanceMethods.Set(
+		"respond",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"respond",
+				httpResponseType,
+				[]*ast.Parameter{httpRequestTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					endpoint, _ := params[0].Extra["endpoint"].(string)
+					resource, ok := this.Extra["resources"].(map[string]string)[endpoint]
+					if !ok {
+						return CreateRaise(NewException(CalloutExceptionType, fmt.Sprintf("No static resource for the endpoint: %s", endpoint)))
+					}
+					return staticResourceResponse(this, resource)
+				},
+			),
+		},
+	)
+
+	webServiceCalloutStaticMethods := ast.NewMethodMap()
+	webServiceCalloutStaticMethods.Set(
+		"invoke",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"invoke",
+				nil,
+				[]*ast.Parameter{
+					objectTypeParameter,
+					objectTypeParameter,
+					{Type: CreateMapType(StringType, ObjectType), Name: "_"},
+					CreateListTypeParameter(StringType),
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if exception := extra["interpreter"].(LimitCounter).Limits().AddCallout(); exception != nil {
+						return CreateRaise(exception)
+					}
+					if exception := checkCalloutAllowed(extra); exception != nil {
+						return CreateRaise(exception)
+					}
+					mock, ok := extra["web_service_mock"].(*ast.Object)
+					if !ok {
+						if extra["interpreter"].(TestContext).IsRunningTest() {
+							return CreateRaise(NewException(CalloutExceptionType, calloutInTestMessage))
+						}
+						return CreateRaise(NewException(CalloutExceptionType, "Web service callouts are not supported"))
+					}
+					// endpoint, soapAction, requestNS, requestName, responseNS, responseName, responseType
+					info := make([]*ast.Object, 7)
+					for i := range info {
+						info[i] = NewString("")
+					}
+					copy(info, params[3].Extra["records"].([]*ast.Object))
+					r := invokeMock(mock, "doInvoke", []*ast.Object{
+						params[0],
+						params[1],
+						params[2],
+						info[0],
+						info[1],
+						info[3],
+						info[4],
+						info[5],
+						info[6],
+					}, extra)
+					if obj, ok := r.(*ast.Object); ok && obj.ClassType == RaiseType {
+						return obj
+					}
+					return nil
+				},
+			),
+		},
+	)
+	webServiceCalloutType := ast.CreateClass(
+		"WebServiceCallout",
+		[]*ast.Method{},
+		ast.NewMethodMap(),
+		webServiceCalloutStaticMethods,
+	)
+
+	primitiveClassMap.Set("HttpCalloutMock", HttpCalloutMockType)
+	primitiveClassMap.Set("WebServiceMock", WebServiceMockType)
+	primitiveClassMap.Set("StaticResourceCalloutMock", staticResourceCalloutMockType)
+	primitiveClassMap.Set("MultiStaticResourceCalloutMock", multiStaticResourceCalloutMockType)
+	primitiveClassMap.Set("WebServiceCallout", webServiceCalloutType)
+}
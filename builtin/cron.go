@@ -0,0 +1,347 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// the positions of the fields in the cron expression
+const (
+	cronSeconds = iota
+	cronMinutes
+	cronHours
+	cronDayOfMonth
+	cronMonth
+	cronDayOfWeek
+	cronYear
+)
+
+type cronFieldSpec struct {
+	min, max     int
+	names        []string // names of the values from min, such as JAN for 1
+	rangeMessage string
+}
+
+var cronFieldSpecs = []cronFieldSpec{
+	{min: 0, max: 59, rangeMessage: "Minute and Second values must be between 0 and 59"},
+	{min: 0, max: 59, rangeMessage: "Minute and Second values must be between 0 and 59"},
+	{min: 0, max: 23, rangeMessage: "Hour values must be between 0 and 23"},
+	{min: 1, max: 31, rangeMessage: "Day of month values must be between 1 and 31"},
+	{
+		min:          1,
+		max:          12,
+		names:        []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"},
+		rangeMessage: "Month values must be between 1 and 12",
+	},
+	{
+		min:          1,
+		max:          7,
+		names:        []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"},
+		rangeMessage: "Day-of-Week values must be between 1 and 7",
+	},
+	{min: 1970, max: 2099, rangeMessage: "Year values must be between 1970 and 2099"},
+}
+
+// CronExpression is the schedule of System.schedule, which consists of
+// Seconds Minutes Hours Day_of_month Month Day_of_week and the optional Year
+type CronExpression struct {
+	Expression string
+
+	fields         [7]map[int]bool // the matching values, nil matches any value
+	noDayOfMonth   bool            // ? in day of month
+	noDayOfWeek    bool            // ? in day of week
+	lastDayOfMonth bool            // L in day of month
+	nearestWeekday int             // W in day of month, such as 15W for the weekday nearest the 15th
+	lastDayOfWeek  bool            // L in day of week, such as 6L for the last Friday of the month
+	nthDayOfWeek   int             // # in day of week, such as 6#3 for the third Friday of the month
+}
+
+// ParseCronExpression parses and validates the cron expression,
+// the error messages follow the StringException thrown by System.schedule
+func ParseCronExpression(expression string) (*CronExpression, error) {
+	fields := strings.Fields(strings.ToUpper(expression))
+	if len(fields) < cronYear {
+		return nil, errors.New("Unexpected end of expression.")
+	}
+	if len(fields) > cronYear+1 {
+		return nil, fmt.Errorf("Unexpected characters after the year: %s", strings.Join(fields[cronYear+1:], " "))
+	}
+	for _, field := range fields[cronSeconds : cronMinutes+1] {
+		if _, err := strconv.Atoi(field); err != nil {
+			return nil, fmt.Errorf("Seconds and minutes must be specified as integers: %s", expression)
+		}
+	}
+	c := &CronExpression{Expression: expression}
+	for i, field := range fields {
+		if err := c.parseField(i, field); err != nil {
+			return nil, err
+		}
+	}
+	if c.noDayOfMonth && c.noDayOfWeek {
+		return nil, errors.New("'?' can only be specified for Day-of-Month -OR- Day-of-Week.")
+	}
+	if !c.noDayOfMonth && !c.noDayOfWeek {
+		return nil, errors.New("Support for specifying both a day-of-week AND a day-of-month parameter is not implemented.")
+	}
+	return c, nil
+}
+
+func (c *CronExpression) parseField(i int, field string) error {
+	if strings.HasPrefix(field, "?") {
+		if field != "?" {
+			return fmt.Errorf("Illegal character after '?': %s", field[1:])
+		}
+		switch i {
+		case cronDayOfMonth:
+			c.noDayOfMonth = true
+		case cronDayOfWeek:
+			c.noDayOfWeek = true
+		default:
+			return errors.New("'?' can only be specified for Day-of-Month or Day-of-Week.")
+		}
+		return nil
+	}
+	if field == "*" {
+		return nil
+	}
+	parts := strings.Split(field, ",")
+	values := map[int]bool{}
+	for _, part := range parts {
+		if (i == cronDayOfMonth && strings.ContainsAny(part, "LW")) || (i == cronDayOfWeek && strings.ContainsAny(part, "L#")) {
+			if len(parts) > 1 {
+				return fmt.Errorf("Support for specifying '%s' with other values is not implemented", part)
+			}
+			return c.parseSpecial(i, part)
+		}
+		if err := parseCronRange(i, part, values); err != nil {
+			return err
+		}
+	}
+	c.fields[i] = values
+	return nil
+}
+
+// parseSpecial parses L and W of day of month, and L and # of day of week
+func (c *CronExpression) parseSpecial(i int, part string) error {
+	if i == cronDayOfMonth {
+		switch {
+		case part == "L":
+			c.lastDayOfMonth = true
+		case part == "LW":
+			c.lastDayOfMonth = true
+			c.nearestWeekday = -1
+		case strings.HasSuffix(part, "W"):
+			day, err := parseCronValue(i, strings.TrimSuffix(part, "W"))
+			if err != nil {
+				return err
+			}
+			c.nearestWeekday = day
+		default:
+			return fmt.Errorf("Illegal characters for this position: '%s'", part)
+		}
+		return nil
+	}
+
+	weekday := part
+	switch {
+	case part == "L":
+		weekday = "7"
+	case strings.HasSuffix(part, "L"):
+		weekday = strings.TrimSuffix(part, "L")
+		c.lastDayOfWeek = true
+	case strings.Contains(part, "#"):
+		values := strings.SplitN(part, "#", 2)
+		weekday = values[0]
+		nth, err := strconv.Atoi(values[1])
+		if err != nil || nth < 1 || nth > 5 {
+			return errors.New("A numeric value between 1 and 5 must follow the '#' option")
+		}
+		c.nthDayOfWeek = nth
+	}
+	value, err := parseCronValue(i, weekday)
+	if err != nil {
+		return err
+	}
+	c.fields[i] = map[int]bool{value: true}
+	return nil
+}
+
+// parseCronRange adds the values of the part such as 5, 1-5, */10 and MON-FRI/2,
+// the range wraps around the maximum such as 22-2 of hours
+func parseCronRange(i int, part string, values map[int]bool) error {
+	spec := cronFieldSpecs[i]
+	size := spec.max - spec.min + 1
+	rangePart, increment := part, 1
+	if index := strings.Index(part, "/"); index >= 0 {
+		rangePart = part[:index]
+		n, err := strconv.Atoi(part[index+1:])
+		if err != nil || n < 1 || n > size {
+			return fmt.Errorf("Increment must be between 1 and %d: %s", size, part)
+		}
+		increment = n
+	}
+
+	var start, end int
+	if rangePart == "*" {
+		start, end = spec.min, spec.max
+	} else if bounds := strings.SplitN(rangePart, "-", 2); len(bounds) == 2 {
+		var err error
+		if start, err = parseCronValue(i, bounds[0]); err != nil {
+			return err
+		}
+		if end, err = parseCronValue(i, bounds[1]); err != nil {
+			return err
+		}
+	} else {
+		var err error
+		if start, err = parseCronValue(i, rangePart); err != nil {
+			return err
+		}
+		end = start
+		if increment > 1 {
+			end = spec.max
+		}
+	}
+
+	span := end - start
+	if span < 0 {
+		span += size
+	}
+	for k := 0; k <= span; k += increment {
+		value := start + k
+		if value > spec.max {
+			value -= size
+		}
+		values[value] = true
+	}
+	return nil
+}
+
+func parseCronValue(i int, s string) (int, error) {
+	spec := cronFieldSpecs[i]
+	for index, name := range spec.names {
+		if s == name {
+			return spec.min + index, nil
+		}
+	}
+	value, err := strconv.Atoi(s)
+	if err != nil {
+		switch i {
+		case cronMonth:
+			return 0, fmt.Errorf("Invalid Month value: '%s'", s)
+		case cronDayOfWeek:
+			return 0, fmt.Errorf("Invalid Day-of-Week value: '%s'", s)
+		}
+		return 0, fmt.Errorf("Illegal characters for this position: '%s'", s)
+	}
+	if value < spec.min || value > spec.max {
+		return 0, errors.New(spec.rangeMessage)
+	}
+	return value, nil
+}
+
+func (c *CronExpression) matches(i int, value int) bool {
+	return c.fields[i] == nil || c.fields[i][value]
+}
+
+// Next returns the first fire time after the time, in the location of the time.
+// false is returned if the schedule never fires until the end of the supported years.
+func (c *CronExpression) Next(after time.Time) (time.Time, bool) {
+	from := after.Truncate(time.Second).Add(time.Second)
+	loc := after.Location()
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	for day.Year() <= cronFieldSpecs[cronYear].max {
+		if !c.matches(cronYear, day.Year()) {
+			day = time.Date(day.Year()+1, time.January, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.matches(cronMonth, int(day.Month())) {
+			day = time.Date(day.Year(), day.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if c.matchesDay(day) {
+			hour, minute, second := 0, 0, 0
+			if day.Year() == from.Year() && day.YearDay() == from.YearDay() {
+				hour, minute, second = from.Hour(), from.Minute(), from.Second()
+			}
+			if next, ok := c.nextTimeOfDay(day, hour, minute, second); ok {
+				return next, true
+			}
+		}
+		day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, loc)
+	}
+	return time.Time{}, false
+}
+
+func (c *CronExpression) nextTimeOfDay(day time.Time, fromHour, fromMinute, fromSecond int) (time.Time, bool) {
+	for hour := fromHour; hour <= cronFieldSpecs[cronHours].max; hour++ {
+		if !c.matches(cronHours, hour) {
+			continue
+		}
+		minute := 0
+		if hour == fromHour {
+			minute = fromMinute
+		}
+		for ; minute <= cronFieldSpecs[cronMinutes].max; minute++ {
+			if !c.matches(cronMinutes, minute) {
+				continue
+			}
+			second := 0
+			if hour == fromHour && minute == fromMinute {
+				second = fromSecond
+			}
+			for ; second <= cronFieldSpecs[cronSeconds].max; second++ {
+				if c.matches(cronSeconds, second) {
+					return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, day.Location()), true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+func (c *CronExpression) matchesDay(day time.Time) bool {
+	lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location()).Day()
+	if !c.noDayOfMonth {
+		switch {
+		case c.lastDayOfMonth && c.nearestWeekday < 0:
+			return day.Day() == nearestWeekday(day, lastDay, lastDay)
+		case c.lastDayOfMonth:
+			return day.Day() == lastDay
+		case c.nearestWeekday > 0:
+			return c.nearestWeekday <= lastDay && day.Day() == nearestWeekday(day, c.nearestWeekday, lastDay)
+		}
+		return c.matches(cronDayOfMonth, day.Day())
+	}
+
+	if !c.matches(cronDayOfWeek, int(day.Weekday())+1) {
+		return false
+	}
+	if c.lastDayOfWeek {
+		return day.Day()+7 > lastDay
+	}
+	if c.nthDayOfWeek > 0 {
+		return (day.Day()-1)/7+1 == c.nthDayOfWeek
+	}
+	return true
+}
+
+// nearestWeekday returns the weekday nearest the day of the month, which does not cross the month
+func nearestWeekday(month time.Time, day, lastDay int) int {
+	switch time.Date(month.Year(), month.Month(), day, 0, 0, 0, 0, month.Location()).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == lastDay {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
@@ -0,0 +1,119 @@
+package builtin
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronExpressionNext(t *testing.T) {
+	// 2026-01-01 is Thursday
+	from := time.Date(2026, time.January, 1, 10, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		Expression string
+		Expected   []string
+	}{
+		{
+			"0 0 12 * * ?",
+			[]string{"2026-01-01 12:00:00", "2026-01-02 12:00:00"},
+		},
+		{
+			"0 30 10 * * ?",
+			[]string{"2026-01-02 10:30:00", "2026-01-03 10:30:00"},
+		},
+		{
+			"15 0 9-17/4 ? * MON-FRI",
+			[]string{"2026-01-01 13:00:15", "2026-01-01 17:00:15", "2026-01-02 09:00:15", "2026-01-02 13:00:15"},
+		},
+		{
+			"0 0 22-2 ? * SAT",
+			[]string{"2026-01-03 00:00:00", "2026-01-03 01:00:00", "2026-01-03 02:00:00", "2026-01-03 22:00:00"},
+		},
+		{
+			"0 0 0 L * ?",
+			[]string{"2026-01-31 00:00:00", "2026-02-28 00:00:00", "2026-03-31 00:00:00"},
+		},
+		{
+			"0 0 0 LW * ?",
+			[]string{"2026-01-30 00:00:00", "2026-02-27 00:00:00", "2026-03-31 00:00:00"},
+		},
+		{
+			"0 0 0 1W * ?",
+			[]string{"2026-02-02 00:00:00", "2026-03-02 00:00:00", "2026-04-01 00:00:00"},
+		},
+		{
+			"0 0 0 ? * 6L",
+			[]string{"2026-01-30 00:00:00", "2026-02-27 00:00:00"},
+		},
+		{
+			"0 0 8 ? * 2#1",
+			[]string{"2026-01-05 08:00:00", "2026-02-02 08:00:00"},
+		},
+		{
+			"0 0 0 29 FEB ? 2026-2030",
+			[]string{"2028-02-29 00:00:00"},
+		},
+		{
+			"0 0 0 1 JAN,JUL ?",
+			[]string{"2026-07-01 00:00:00", "2027-01-01 00:00:00"},
+		},
+	}
+	for _, testCase := range testCases {
+		c, err := ParseCronExpression(testCase.Expression)
+		if err != nil {
+			t.Fatalf("%s: %s", testCase.Expression, err.Error())
+		}
+		at := from
+		for _, expected := range testCase.Expected {
+			next, ok := c.Next(at)
+			if !ok {
+				t.Fatalf("%s: expected %s, actual never fires", testCase.Expression, expected)
+			}
+			if actual := next.Format("2006-01-02 15:04:05"); actual != expected {
+				t.Errorf("%s: expected %s, actual %s", testCase.Expression, expected, actual)
+			}
+			at = next
+		}
+	}
+
+	c, err := ParseCronExpression("0 0 0 1 1 ? 2020")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next, ok := c.Next(from); ok {
+		t.Errorf("expected never fires, actual %s", next)
+	}
+}
+
+func TestParseCronExpressionError(t *testing.T) {
+	testCases := []struct {
+		Expression string
+		Expected   string
+	}{
+		{"0 0 12 * *", "Unexpected end of expression."},
+		{"0 0 12 * * ? 2026 1", "Unexpected characters after the year: 1"},
+		{"0 0/30 * * * ?", "Seconds and minutes must be specified as integers: 0 0/30 * * * ?"},
+		{"0 60 * * * ?", "Minute and Second values must be between 0 and 59"},
+		{"0 0 24 * * ?", "Hour values must be between 0 and 23"},
+		{"0 0 12 32 * ?", "Day of month values must be between 1 and 31"},
+		{"0 0 12 ? FOO *", "Invalid Month value: 'FOO'"},
+		{"0 0 12 ? * FOO", "Invalid Day-of-Week value: 'FOO'"},
+		{"0 0 12 ? * 8", "Day-of-Week values must be between 1 and 7"},
+		{"0 0 12 * * ? 1969", "Year values must be between 1970 and 2099"},
+		{"0 0 12 * * *", "Support for specifying both a day-of-week AND a day-of-month parameter is not implemented."},
+		{"0 0 12 ? * ?", "'?' can only be specified for Day-of-Month -OR- Day-of-Week."},
+		{"0 0 ? * * *", "'?' can only be specified for Day-of-Month or Day-of-Week."},
+		{"0 0 12 L,15 * ?", "Support for specifying 'L' with other values is not implemented"},
+		{"0 0 12 ? * 6#6", "A numeric value between 1 and 5 must follow the '#' option"},
+		{"0 0 */25 * * ?", "Increment must be between 1 and 24: */25"},
+	}
+	for _, testCase := range testCases {
+		_, err := ParseCronExpression(testCase.Expression)
+		if err == nil {
+			t.Errorf("%s: expected error %s", testCase.Expression, testCase.Expected)
+			continue
+		}
+		if err.Error() != testCase.Expected {
+			t.Errorf("%s: expected %s, actual %s", testCase.Expression, testCase.Expected, err.Error())
+		}
+	}
+}
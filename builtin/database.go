@@ -1,10 +1,147 @@
 package builtin
 
 import (
+	"fmt"
+
 	"github.com/tzmfreedom/land/ast"
 )
 
-var saveResultType *ast.ClassType
+// DmlExecutor executes dml firing triggers, which is implemented by interpreter.
+// The list of the results of the records is returned, DmlException is raised if any record fails and allOrNone is true.
+type DmlExecutor interface {
+	ExecuteDml(dmlType string, records []*ast.Object, upsertKey string, allOrNone bool) (*ast.Object, error)
+}
+
+func executeDml(extra map[string]interface{}, dmlType string, records []*ast.Object, upsertKey string, allOrNone bool) interface{} {
+	executor := extra["interpreter"].(DmlExecutor)
+	result, err := executor.ExecuteDml(dmlType, records, upsertKey, allOrNone)
+	if err != nil {
+		if raise, ok := err.(*RaiseError); ok {
+			return CreateRaise(raise.Exception)
+		}
+		return CreateRaise(NewException(DmlExceptionType, err.Error()))
+	}
+	return result
+}
+
+// dmlMethods creates the overloads of Database DML method for a record and a list of records,
+// which are followed by the key parameters of upsert and the option of allOrNone
+func dmlMethods(dmlType string, keyParameters ...*ast.Parameter) []*ast.Method {
+	resultType := dmlResultType(dmlType)
+	optionParameters := [][]*ast.Parameter{{}, {booleanTypeParameter}}
+	if dmlType == "insert" || dmlType == "update" {
+		optionParameters = append(optionParameters, []*ast.Parameter{dmlOptionsTypeParameter})
+	}
+	methods := []*ast.Method{}
+	for _, options := range optionParameters {
+		options := options
+		parameters := append(append([]*ast.Parameter{SObjectTypeParameter}, keyParameters...), options...)
+		listParameters := append(append([]*ast.Parameter{CreateListTypeParameter(SObjectType)}, keyParameters...), options...)
+		methods = append(
+			methods,
+			ast.CreateMethod(
+				dmlType,
+				resultType,
+				parameters,
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records := []*ast.Object{params[0]}
+					result := executeDml(extra, dmlType, records, upsertKey(params, keyParameters), allOrNone(params, options))
+					if list, ok := result.(*ast.Object); ok && list.ClassType != RaiseType {
+						return list.Extra["records"].([]*ast.Object)[0]
+					}
+					return result
+				},
+			),
+			ast.CreateMethod(
+				dmlType,
+				CreateListType(resultType),
+				listParameters,
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records := params[0].Extra["records"].([]*ast.Object)
+					return executeDml(extra, dmlType, records, upsertKey(params, keyParameters), allOrNone(params, options))
+				},
+			),
+		)
+	}
+	return methods
+}
+
+// upsertKey returns the external Id field of upsert, which is Id if it is not given
+func upsertKey(params []*ast.Object, keyParameters []*ast.Parameter) string {
+	if len(keyParameters) == 0 || params[1] == Null {
+		return ""
+	}
+	return params[1].StringValue()
+}
+
+// allOrNone returns whether the DML fails if any record fails, which is true without the option.
+// The option is the Boolean or Database.DMLOptions whose optAllOrNone is false unless it is set.
+func allOrNone(params []*ast.Object, options []*ast.Parameter) bool {
+	if len(options) == 0 {
+		return true
+	}
+	option := params[len(params)-1]
+	if options[0] == dmlOptionsTypeParameter {
+		if option == Null {
+			return false
+		}
+		value, ok := option.InstanceFields.Get("optAllOrNone")
+		return ok && value != Null && value.BoolValue()
+	}
+	return option == Null || option.BoolValue()
+}
+
+// defaultBatchSize is the scope of Database.executeBatch without the scope parameter,
+// and the scope of QueryLocator is up to MaxQueryLocatorBatchSize
+const defaultBatchSize = 200
+const MaxQueryLocatorBatchSize = 2000
+
+// QueryExecutor executes the SOQL query given as a string, which is implemented by interpreter.
+// The bind variables are resolved from the map of binds, or the calling scope if binds is nil.
+// The rows of QueryLocator are not counted in the query rows limit.
+type QueryExecutor interface {
+	Query(query string, binds *ast.Object, countRows bool) (*ast.Object, error)
+	CountQuery(query string, binds *ast.Object) (*ast.Object, error)
+}
+
+// AccessLevelType is the mode of Database.queryWithBinds and the others,
+// which are the same as land does not enforce the sharing and the permissions
+var AccessLevelType = CreateEnumType("AccessLevel", []string{"SYSTEM_MODE", "USER_MODE"})
+
+var accessLevelTypeParameter = &ast.Parameter{
+	Type: AccessLevelType,
+	Name: "_",
+}
+
+var bindMapTypeParameter = &ast.Parameter{
+	Type: CreateMapType(StringType, ObjectType),
+	Name: "_",
+}
+
+func executeQuery(extra map[string]interface{}, query string, binds *ast.Object) interface{} {
+	records, err := extra["interpreter"].(QueryExecutor).Query(query, binds, true)
+	if err != nil {
+		return queryError(err)
+	}
+	return records
+}
+
+func executeCountQuery(extra map[string]interface{}, query string, binds *ast.Object) interface{} {
+	count, err := extra["interpreter"].(QueryExecutor).CountQuery(query, binds)
+	if err != nil {
+		return queryError(err)
+	}
+	return count
+}
+
+// queryError raises the exception of the query, the errors of the storage are raised as QueryException
+func queryError(err error) *ast.Object {
+	if raise, ok := err.(*RaiseError); ok {
+		return CreateRaise(raise.Exception)
+	}
+	return CreateRaise(NewException(QueryExceptionType, err.Error()))
+}
+
 var queryLocatorType = ast.CreateClass(
 	"QueryLocator",
 	[]*ast.Method{},
@@ -12,161 +149,219 @@ var queryLocatorType = ast.CreateClass(
 	ast.NewMethodMap(),
 )
 
+var batchableContextType = ast.CreateClass(
+	"BatchableContext",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var BatchableType = ast.CreateClass(
+	"Batchable",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	nil,
+)
+
+// StatefulType is the marker interface to retain the instance state of the batch across the transactions
+var StatefulType = ast.CreateClass(
+	"Stateful",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+// AllowsCalloutsType is the marker interface to make callouts from the batch
+var AllowsCalloutsType = ast.CreateClass(
+	"AllowsCallouts",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+// NewBatchableContext creates the context passed to the methods of Database.Batchable
+func NewBatchableContext(jobId string) *ast.Object {
+	obj := ast.CreateObject(batchableContextType)
+	obj.Extra["jobId"] = jobId
+	return obj
+}
+
+// IsQueryLocator reports whether the object is returned by Database.getQueryLocator
+func IsQueryLocator(obj *ast.Object) bool {
+	return obj.ClassType == queryLocatorType
+}
+
+func newQueryLocator(query string, records *ast.Object) *ast.Object {
+	locator := ast.CreateObject(queryLocatorType)
+	locator.Extra["query"] = query
+	locator.Extra["records"] = records.Extra["records"]
+	locator.Extra["listType"] = records.ClassType
+	return locator
+}
+
+func executeBatch(batchable *ast.Object, scope int, extra map[string]interface{}) interface{} {
+	if batchable == Null {
+		return CreateRaise(NewException(NullPointerExceptionType, "Argument cannot be null"))
+	}
+	if !Implements(batchable.ClassType, BatchableType) {
+		return CreateRaise(NewException(TypeExceptionType, fmt.Sprintf("Class %s must implement the Database.Batchable interface", batchable.ClassType.Name)))
+	}
+	if scope <= 0 {
+		return CreateRaise(NewException(AsyncExceptionType, "Batch size must be greater than 0"))
+	}
+	jobId, err := extra["interpreter"].(AsyncExecutor).ExecuteBatch(batchable, scope)
+	if err != nil {
+		if raise, ok := err.(*RaiseError); ok {
+			return CreateRaise(raise.Exception)
+		}
+		panic(err)
+	}
+	return NewString(jobId)
+}
+
 func init() {
 	staticMethods := ast.NewMethodMap()
 
-	staticMethods.Set("insert", []*ast.Method{
+	staticMethods.Set("insert", dmlMethods("insert"))
+	staticMethods.Set("update", dmlMethods("update"))
+	staticMethods.Set("delete", dmlMethods("delete"))
+	staticMethods.Set("upsert", append(dmlMethods("upsert"), dmlMethods("upsert", stringTypeParameter)...))
+	staticMethods.Set("undelete", dmlMethods("undelete"))
+
+	staticMethods.Set("setSavepoint", []*ast.Method{
 		ast.CreateMethod(
-			"insert",
-			saveResultType,
-			[]*ast.Parameter{SObjectTypeParameter},
+			"setSavepoint",
+			SavepointType,
+			[]*ast.Parameter{},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-				obj := params[0]
-				records := []*ast.Object{obj}
-				return DatabaseDriver.Execute("insert", obj.ClassType.Name, records, "")
+				return setSavepoint(extra)
 			},
 		),
+	})
+
+	staticMethods.Set("rollback", []*ast.Method{
 		ast.CreateMethod(
-			"insert",
-			saveResultType,
-			[]*ast.Parameter{
-				{
-					Type: CreateListType(SObjectType),
-					Name: "_",
-				},
-			},
+			"rollback",
+			nil,
+			[]*ast.Parameter{{Type: SavepointType, Name: "_"}},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-				obj := params[0]
-				records := obj.Extra["records"].([]*ast.Object)
-				sObjectType := records[0].ClassType.Name
-				return DatabaseDriver.Execute("insert", sObjectType, records, "")
+				return rollbackToSavepoint(params[0], extra)
 			},
 		),
 	})
 
-	staticMethods.Set("update", []*ast.Method{
+	staticMethods.Set("query", []*ast.Method{
 		ast.CreateMethod(
-			"update",
-			saveResultType,
-			[]*ast.Parameter{objectTypeParameter},
+			"query",
+			CreateListType(SObjectType),
+			[]*ast.Parameter{stringTypeParameter},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-				obj := params[0]
-				records := []*ast.Object{obj}
-				return DatabaseDriver.Execute("update", obj.ClassType.Name, records, "")
+				return executeQuery(extra, params[0].StringValue(), nil)
 			},
 		),
 		ast.CreateMethod(
-			"update",
-			saveResultType,
-			[]*ast.Parameter{
-				{
-					Type: CreateListType(SObjectType),
-					Name: "_",
-				},
+			"query",
+			CreateListType(SObjectType),
+			[]*ast.Parameter{stringTypeParameter, accessLevelTypeParameter},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return executeQuery(extra, params[0].StringValue(), nil)
 			},
+		),
+	})
+
+	staticMethods.Set("queryWithBinds", []*ast.Method{
+		ast.CreateMethod(
+			"queryWithBinds",
+			CreateListType(SObjectType),
+			[]*ast.Parameter{stringTypeParameter, bindMapTypeParameter, accessLevelTypeParameter},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-				obj := params[0]
-				records := obj.Extra["records"].([]*ast.Object)
-				sObjectType := records[0].ClassType.Name
-				return DatabaseDriver.Execute("update", sObjectType, records, "")
+				if params[1] == Null {
+					return CreateRaise(NewException(NullPointerExceptionType, "Argument cannot be null"))
+				}
+				return executeQuery(extra, params[0].StringValue(), params[1])
 			},
 		),
 	})
 
-	staticMethods.Set("delete", []*ast.Method{
+	staticMethods.Set("countQuery", []*ast.Method{
 		ast.CreateMethod(
-			"delete",
-			saveResultType,
-			[]*ast.Parameter{objectTypeParameter},
+			"countQuery",
+			IntegerType,
+			[]*ast.Parameter{stringTypeParameter},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-				obj := params[0]
-				records := []*ast.Object{obj}
-				return DatabaseDriver.Execute("delete", obj.ClassType.Name, records, "")
+				return executeCountQuery(extra, params[0].StringValue(), nil)
 			},
 		),
 		ast.CreateMethod(
-			"delete",
-			saveResultType,
-			[]*ast.Parameter{
-				{
-					Type: CreateListType(SObjectType),
-					Name: "_",
-				},
+			"countQuery",
+			IntegerType,
+			[]*ast.Parameter{stringTypeParameter, accessLevelTypeParameter},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return executeCountQuery(extra, params[0].StringValue(), nil)
 			},
+		),
+	})
+
+	staticMethods.Set("countQueryWithBinds", []*ast.Method{
+		ast.CreateMethod(
+			"countQueryWithBinds",
+			IntegerType,
+			[]*ast.Parameter{stringTypeParameter, bindMapTypeParameter, accessLevelTypeParameter},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-				obj := params[0]
-				records := obj.Extra["records"].([]*ast.Object)
-				sObjectType := records[0].ClassType.Name
-				return DatabaseDriver.Execute("delete", sObjectType, records, "")
+				if params[1] == Null {
+					return CreateRaise(NewException(NullPointerExceptionType, "Argument cannot be null"))
+				}
+				return executeCountQuery(extra, params[0].StringValue(), params[1])
 			},
 		),
 	})
 
-	staticMethods.Set("upsert", []*ast.Method{
+	staticMethods.Set("getQueryLocator", []*ast.Method{
 		ast.CreateMethod(
-			"upsert",
-			saveResultType,
-			[]*ast.Parameter{
-				SObjectTypeParameter,
-				stringTypeParameter,
+			"getQueryLocator",
+			queryLocatorType,
+			[]*ast.Parameter{stringTypeParameter},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				query := params[0].StringValue()
+				records, err := extra["interpreter"].(QueryExecutor).Query(query, nil, false)
+				if err != nil {
+					if raise, ok := err.(*RaiseError); ok {
+						return CreateRaise(raise.Exception)
+					}
+					panic(err)
+				}
+				return newQueryLocator(query, records)
 			},
+		),
+		ast.CreateMethod(
+			"getQueryLocator",
+			queryLocatorType,
+			[]*ast.Parameter{CreateListTypeParameter(SObjectType)},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-				obj := params[0]
-				key := params[1].StringValue()
-				records := []*ast.Object{obj}
-				return DatabaseDriver.Execute("upsert", obj.ClassType.Name, records, key)
+				return newQueryLocator("", params[0])
 			},
 		),
+	})
+
+	staticMethods.Set("executeBatch", []*ast.Method{
 		ast.CreateMethod(
-			"upsert",
-			saveResultType,
-			[]*ast.Parameter{
-				{
-					Type: CreateListType(SObjectType),
-					Name: "_",
-				},
-				stringTypeParameter,
+			"executeBatch",
+			StringType,
+			[]*ast.Parameter{objectTypeParameter},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return executeBatch(params[0], defaultBatchSize, extra)
 			},
+		),
+		ast.CreateMethod(
+			"executeBatch",
+			StringType,
+			[]*ast.Parameter{objectTypeParameter, IntegerTypeParameter},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-				obj := params[0]
-				key := params[1].StringValue()
-				records := obj.Extra["records"].([]*ast.Object)
-				sObjectType := records[0].ClassType.Name
-				return DatabaseDriver.Execute("upsert", sObjectType, records, key)
+				return executeBatch(params[0], params[1].IntegerValue(), extra)
 			},
 		),
 	})
 
-	method := ast.CreateMethod(
-		"setSavePoint",
-		nil, // TODO: implement
-		[]*ast.Parameter{},
-		func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-			return Null
-		},
-	)
-	staticMethods.Set("setSavePoint", []*ast.Method{method})
-
-	method = ast.CreateMethod(
-		"rollback",
-		nil,
-		[]*ast.Parameter{objectTypeParameter}, // TODO: savepoint
-		func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-			return Null
-		},
-	)
-	staticMethods.Set("rollback", []*ast.Method{method})
-
-	method = ast.CreateMethod(
-		"getQueryLocator",
-		queryLocatorType,
-		[]*ast.Parameter{stringTypeParameter},
-		func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-			return Null
-		},
-	)
-	staticMethods.Set("getQueryLocator", []*ast.Method{method})
-
 	databaseClass := ast.CreateClass(
 		"Database",
 		[]*ast.Method{},
@@ -175,72 +370,50 @@ func init() {
 	)
 	primitiveClassMap.Set("Database", databaseClass)
 
-	instanceMethods := ast.NewMethodMap()
-	instanceMethods.Set(
-		"getErrors",
-		[]*ast.Method{
-			ast.CreateMethod(
-				"getErrors",
-				CreateListType(StringType),
-				[]*ast.Parameter{},
-				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					return this.Extra["errors"]
-				},
-			),
-		},
-	)
-	instanceMethods.Set(
-		"getId",
+	classMap := ast.NewClassMap()
+	classMap.Set("SaveResult", saveResultType)
+	classMap.Set("UpsertResult", upsertResultType)
+	classMap.Set("DeleteResult", deleteResultType)
+	classMap.Set("UndeleteResult", undeleteResultType)
+	classMap.Set("Error", databaseErrorType)
+	classMap.Set("DMLOptions", dmlOptionsType)
+
+	queryLocatorType.InstanceMethods.Set(
+		"getQuery",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"getId",
+				"getQuery",
 				StringType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					return this.Extra["id"]
+					return NewString(this.Extra["query"].(string))
 				},
 			),
 		},
 	)
-	instanceMethods.Set(
-		"isSuccess",
+	classMap.Set("QueryLocator", queryLocatorType)
+
+	batchableContextType.InstanceMethods.Set(
+		"getJobId",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"isSuccess",
-				BooleanType,
+				"getJobId",
+				StringType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					return this.Extra["isSuccess"]
+					return NewString(this.Extra["jobId"].(string))
 				},
 			),
 		},
 	)
-
-	classMap := ast.NewClassMap()
-	saveResultType = ast.CreateClass(
-		"SaveResult",
-		[]*ast.Method{},
-		instanceMethods,
-		ast.NewMethodMap(),
-	)
-	classMap.Set("SaveResult", saveResultType)
-	classMap.Set("QueryLocator", queryLocatorType)
-
-	batchableContext := ast.CreateClass(
-		"BatchableContext",
-		[]*ast.Method{},
-		ast.NewMethodMap(),
-		ast.NewMethodMap(),
-	)
-	classMap.Set("BatchableContext", batchableContext)
+	classMap.Set("BatchableContext", batchableContextType)
 
 	batchableContextTypeParameter := &ast.Parameter{
-		Type: batchableContext,
+		Type: batchableContextType,
 		Name: "_",
 	}
 
-	instanceMethods = ast.NewMethodMap()
-	instanceMethods.Set(
+	BatchableType.InstanceMethods.Set(
 		"start",
 		[]*ast.Method{
 			ast.CreateMethod(
@@ -253,7 +426,7 @@ func init() {
 			),
 		},
 	)
-	instanceMethods.Set(
+	BatchableType.InstanceMethods.Set(
 		"execute",
 		[]*ast.Method{
 			ast.CreateMethod(
@@ -267,7 +440,7 @@ func init() {
 			),
 		},
 	)
-	instanceMethods.Set(
+	BatchableType.InstanceMethods.Set(
 		"finish",
 		[]*ast.Method{
 			ast.CreateMethod(
@@ -280,15 +453,13 @@ func init() {
 			),
 		},
 	)
+	BatchableType.Interface = true
+	classMap.Set("Batchable", BatchableType)
 
-	batchable := ast.CreateClass(
-		"Batchable",
-		[]*ast.Method{},
-		instanceMethods,
-		nil,
-	)
-	batchable.Interface = true
-	classMap.Set("Batchable", batchable)
+	StatefulType.Interface = true
+	classMap.Set("Stateful", StatefulType)
+	AllowsCalloutsType.Interface = true
+	classMap.Set("AllowsCallouts", AllowsCalloutsType)
 
 	nameSpaceStore.Set("Database", classMap)
 }
@@ -24,6 +24,9 @@ var dateTypeParameter = &ast.Parameter{
 }
 
 func init() {
+	DateType.ToString = func(o *ast.Object) string {
+		return o.Value().(time.Time).Format(dateLayout)
+	}
 	DateType.InstanceMethods.Set(
 		"addDays",
 		[]*ast.Method{
@@ -329,7 +332,7 @@ func init() {
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 					obj := ast.CreateObject(DateType)
-					obj.Extra["value"] = time.Now()
+					obj.Extra["value"] = Now()
 					return obj
 				},
 			),
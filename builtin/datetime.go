@@ -11,6 +11,9 @@ var datetimeTypeRef = &ast.TypeRef{
 	Parameters: []*ast.TypeRef{},
 }
 
+// Now returns the current time, which is replaced with the virtual clock by land schedule
+var Now = time.Now
+
 var DatetimeType = ast.CreateClass(
 	"Datetime",
 	[]*ast.Method{},
@@ -19,6 +22,9 @@ var DatetimeType = ast.CreateClass(
 )
 
 func init() {
+	DatetimeType.ToString = func(o *ast.Object) string {
+		return o.Value().(time.Time).UTC().Format(datetimeLayout)
+	}
 	DatetimeType.InstanceMethods.Set(
 		"year",
 		[]*ast.Method{
@@ -144,11 +150,11 @@ func init() {
 		[]*ast.Method{
 			ast.CreateMethod(
 				"now",
-				DateType,
+				DatetimeType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 					obj := ast.CreateObject(DatetimeType)
-					obj.Extra["value"] = time.Now()
+					obj.Extra["value"] = Now()
 					return obj
 				},
 			),
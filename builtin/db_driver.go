@@ -1,176 +1,385 @@
 package builtin
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 
 	"fmt"
+	"strconv"
 	"strings"
-
-	"math/rand"
 	"time"
 
-	"github.com/k0kubun/pp"
-	_ "github.com/mattn/go-sqlite3"
+	_ "github.com/lib/pq"
 	"github.com/tzmfreedom/land/ast"
 )
 
-type databaseDriver struct {
-	db *sql.DB
+// sqlStorage is the storage of database/sql, which is SQLite or PostgreSQL by the dialect
+type sqlStorage struct {
+	dialect dialect
+	dsn     string
+	db      *sql.DB
+	session *sql.Conn
 }
 
-var DatabaseDriver = NewDatabaseDriver()
+func newSqlStorage(dialect dialect, dsn string) *sqlStorage {
+	return &sqlStorage{dialect: dialect, dsn: dsn}
+}
 
-func NewDatabaseDriver() *databaseDriver {
-	// TODO: implment not sqlite3
-	db, _ := sql.Open("sqlite3", "./database.sqlite3")
-	return &databaseDriver{db}
+// conn returns the connection of the session, which is opened on the first use.
+// The in-memory SQLite database lives as long as the connection.
+func (d *sqlStorage) conn() (*sql.Conn, error) {
+	if d.session != nil {
+		return d.session, nil
+	}
+	db, err := sql.Open(d.dialect.DriverName(), d.dsn)
+	if err != nil {
+		return nil, err
+	}
+	session, err := db.Conn(context.Background())
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	d.db = db
+	d.session = session
+	return session, nil
+}
+
+func (d *sqlStorage) exec(query string, args ...interface{}) (sql.Result, error) {
+	defer measureStorageTime(time.Now())
+	conn, err := d.conn()
+	if err != nil {
+		return nil, err
+	}
+	return conn.ExecContext(context.Background(), d.dialect.Rebind(query), args...)
+}
+
+func (d *sqlStorage) query(query string, args ...interface{}) (*sql.Rows, error) {
+	conn, err := d.conn()
+	if err != nil {
+		return nil, err
+	}
+	return conn.QueryContext(context.Background(), d.dialect.Rebind(query), args...)
 }
 
-func (d *databaseDriver) Query(n *ast.Soql, interpreter ast.Visitor) []*ast.Object {
-	builder := SqlBuilder{interpreter: interpreter}
-	sql, selectFields, relations := builder.Build(n)
-	// pp.Println(sql)
+func (d *sqlStorage) Query(n *ast.Soql, interpreter ast.Visitor) ([]*ast.Object, error) {
+	defer measureStorageTime(time.Now())
+	builder := SqlBuilder{interpreter: interpreter, dialect: d.dialect}
+	query, args, selectFields, relations, err := builder.Build(n)
+	if err != nil {
+		return nil, err
+	}
 
-	rows, err := d.db.Query(sql)
+	rows, err := d.query(query, args...)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
+	defer rows.Close()
 
 	classType, _ := PrimitiveClassMap().Get(n.FromObject)
+	aggregated := IsAggregateQuery(n)
 	records := []*ast.Object{}
 	for rows.Next() {
 		dispatches := make([]interface{}, len(selectFields))
-		for i, _ := range selectFields {
-			var temp string
-			dispatches[i] = &temp
+		for i := range selectFields {
+			dispatches[i] = &sql.NullString{}
 		}
 		err := rows.Scan(dispatches...)
 		if err != nil {
-			panic(err)
+			return nil, err
+		}
+		if aggregated {
+			records = append(records, newSqlAggregateResult(n, selectFields, relations, dispatches))
+			continue
 		}
 		record := ast.CreateObject(classType)
 		for i, field := range selectFields {
 			tmpTable := field[0]
 			fieldName := field[1]
-			value := NewString(*dispatches[i].(*string))
+			value := dispatches[i].(*sql.NullString)
 
 			if tmpTable == "t0" {
-				record.InstanceFields.Set(fieldName, value)
+				record.InstanceFields.Set(fieldName, newNullableFieldValue(n.FromObject, fieldName, value))
 				continue
 			}
 			relationInfo := relations[tmpTable]
-			relationField, ok := record.InstanceFields.Get(relationInfo.RelationshipName)
-			if ok {
-				relationField.InstanceFields.Set(fieldName, value)
-			} else {
-				relationType, _ := PrimitiveClassMap().Get(relationInfo.ReferenceTo)
-				relationField = ast.CreateObject(relationType)
-				relationField.InstanceFields.Set(fieldName, value)
-				record.InstanceFields.Set(relationInfo.RelationshipName, relationField)
-			}
+			relationValue := newNullableFieldValue(relationInfo.ReferenceTo, fieldName, value)
+			relationObject(record, tmpTable, relations).InstanceFields.Set(fieldName, relationValue)
 		}
 		records = append(records, record)
 	}
-	return records
+	return records, rows.Err()
 }
 
-func (d *databaseDriver) QueryRaw(query string) {
-	rows, err := d.db.Query(query)
-	if err != nil {
-		panic(err)
+// relationObject returns the parent record of the joined table nested in the record, such as Contact.Account.Owner
+func relationObject(record *ast.Object, tmpTable string, relations map[string]Relation) *ast.Object {
+	if tmpTable == "t0" {
+		return record
+	}
+	relationInfo := relations[tmpTable]
+	parent := relationObject(record, relationInfo.Parent, relations)
+	relationField, ok := parent.InstanceFields.Get(relationInfo.RelationshipName)
+	if !ok {
+		relationType, _ := PrimitiveClassMap().Get(relationInfo.ReferenceTo)
+		relationField = ast.CreateObject(relationType)
+		parent.InstanceFields.Set(relationInfo.RelationshipName, relationField)
+	}
+	return relationField
+}
+
+// newSqlAggregateResult creates the AggregateResult of the row, the values of the functions are converted to
+// Integer for COUNT, COUNT_DISTINCT and GROUPING, Double for SUM and AVG, and the field type for MIN and MAX
+func newSqlAggregateResult(n *ast.Soql, selectFields [][]string, relations map[string]Relation, dispatches []interface{}) *ast.Object {
+	result := ast.CreateObject(AggregateResultType)
+	for i, name := range AggregateFieldNames(n) {
+		value := dispatches[i].(*sql.NullString)
+		field := selectFields[i]
+		sObjectType := n.FromObject
+		if relation, ok := relations[field[0]]; ok {
+			sObjectType = relation.ReferenceTo
+		}
+		if len(field) == 2 {
+			result.InstanceFields.Set(name, newNullableFieldValue(sObjectType, field[1], value))
+			continue
+		}
+		if !value.Valid {
+			result.InstanceFields.Set(name, Null)
+			continue
+		}
+		switch field[2] {
+		case "COUNT", "COUNT_DISTINCT", "GROUPING":
+			count, err := strconv.Atoi(value.String)
+			if err != nil {
+				panic(err)
+			}
+			result.InstanceFields.Set(name, NewInteger(count))
+		case "SUM", "AVG":
+			number, err := strconv.ParseFloat(value.String, 64)
+			if err != nil {
+				panic(err)
+			}
+			result.InstanceFields.Set(name, NewDouble(number))
+		default:
+			result.InstanceFields.Set(name, newFieldValue(sObjectType, field[1], value.String))
+		}
 	}
-	pp.Println(rows)
+	return result
+}
+
+func (d *sqlStorage) Quote(identifier string) string {
+	return d.dialect.Quote(identifier)
+}
+
+func (d *sqlStorage) Begin() error {
+	_, err := d.exec("BEGIN")
+	return err
+}
+
+func (d *sqlStorage) Commit() error {
+	_, err := d.exec("COMMIT")
+	return err
+}
+
+func (d *sqlStorage) Rollback() error {
+	_, err := d.exec("ROLLBACK")
+	return err
 }
 
-func (d *databaseDriver) Begin() {
-	d.db.Exec("BEGIN;")
+func (d *sqlStorage) Savepoint(name string) error {
+	_, err := d.exec("SAVEPOINT " + name)
+	return err
+}
+
+func (d *sqlStorage) RollbackToSavepoint(name string) error {
+	_, err := d.exec("ROLLBACK TO SAVEPOINT " + name)
+	return err
 }
 
-func (d *databaseDriver) Rollback() {
-	d.db.Exec("ROLLBACK;")
+func (d *sqlStorage) ReleaseSavepoint(name string) error {
+	_, err := d.exec("RELEASE SAVEPOINT " + name)
+	return err
+}
+
+func (d *sqlStorage) Close() error {
+	if d.session == nil {
+		return nil
+	}
+	d.session.Close()
+	d.session = nil
+	return d.db.Close()
 }
 
-func (d *databaseDriver) Execute(dmlType string, sObjectType string, records []*ast.Object, upsertKey string) *ast.Object {
+func (d *sqlStorage) Execute(dmlType string, sObjectType string, records []*ast.Object, upsertKey string) (*ast.Object, error) {
 	saveResults := make([]*ast.Object, len(records))
 	for i, record := range records {
 		var query string
+		args := []interface{}{}
 
 		switch dmlType {
-		case "insert":
+		case "insert", "undelete":
 			fields := []string{}
-			values := []string{}
-			rand.Seed(time.Now().UnixNano())
-			record.InstanceFields.Set("Id", NewString(string(rand.Int())))
+			placeholders := []string{}
+			// deleted records are inserted again with their Id on undelete
+			if dmlType == "insert" {
+				record.InstanceFields.Set("Id", NewId(newRecordId(sObjectType)))
+			}
 			for name, field := range record.InstanceFields.All() {
-				// TODO: convert type
-				if field == Null {
+				if !isSavedField(record, name, field) {
 					continue
 				}
-				fields = append(fields, name)
-				values = append(values, fmt.Sprintf("'%s'", field.StringValue()))
+				fields = append(fields, d.dialect.Quote(name))
+				placeholders = append(placeholders, "?")
+				args = append(args, fieldArg(field))
 			}
 			query = fmt.Sprintf(
 				"INSERT INTO %s(%s) VALUES (%s)",
-				sObjectType,
+				d.dialect.Quote(sObjectType),
 				strings.Join(fields, ", "),
-				strings.Join(values, ", "),
+				strings.Join(placeholders, ", "),
 			)
 		case "update":
 			updateFields := []string{}
 			for name, field := range record.InstanceFields.All() {
-				// TODO: convert type
-				if field == Null {
+				if !isSavedField(record, name, field) {
 					continue
 				}
-				updateFields = append(updateFields, fmt.Sprintf("%s = '%s'", name, field.StringValue()))
+				updateFields = append(updateFields, fmt.Sprintf("%s = ?", d.dialect.Quote(name)))
+				args = append(args, fieldArg(field))
 			}
 			id, ok := record.InstanceFields.Get("Id")
-			if !ok {
-				panic("id does not exist")
+			if !ok || id == Null {
+				return nil, errors.New("id does not exist")
 			}
 			query = fmt.Sprintf(
-				"UPDATE %s SET %s WHERE id = '%s'",
-				sObjectType,
+				"UPDATE %s SET %s WHERE id = ?",
+				d.dialect.Quote(sObjectType),
 				strings.Join(updateFields, ", "),
-				id.StringValue(),
 			)
-		case "upsert":
-			// TODO: implement
+			args = append(args, fieldText(id))
 		case "delete":
 			id, ok := record.InstanceFields.Get("Id")
-			if !ok {
-				panic("id does not exist")
+			if !ok || id == Null {
+				return nil, errors.New("id does not exist")
 			}
-			query = fmt.Sprintf(
-				"DELETE FROM %s WHERE id = '%s'",
-				sObjectType,
-				id.StringValue(),
-			)
+			query = fmt.Sprintf("DELETE FROM %s WHERE id = ?", d.dialect.Quote(sObjectType))
+			args = append(args, fieldText(id))
 		}
-		result, err := d.db.Exec(query)
-		if err != nil {
-			panic(err)
+		if _, err := d.exec(query, args...); err != nil {
+			return nil, err
 		}
-		obj := ast.CreateObject(saveResultType)
-		obj.Extra["isSuccess"] = NewBoolean(err == nil)
-		if err == nil {
-			id, err := result.LastInsertId()
-			if err != nil {
-				panic(err)
-			}
-			obj.Extra["id"] = NewString(fmt.Sprintf("%d", id))
-		} else {
-			obj.Extra["errors"] = err.Error()
+		saveResults[i] = newSaveResult(dmlType, record)
+	}
+	return CreateListObject(nil, saveResults), nil
+}
+
+func (d *sqlStorage) Insert(sObjectType string, values map[string]interface{}) error {
+	fields := []string{}
+	placeholders := []string{}
+	args := []interface{}{}
+	for name, value := range values {
+		fields = append(fields, d.dialect.Quote(name))
+		placeholders = append(placeholders, "?")
+		args = append(args, value)
+	}
+	query := fmt.Sprintf(
+		"INSERT INTO %s(%s) VALUES (%s)",
+		d.dialect.Quote(sObjectType),
+		strings.Join(fields, ", "),
+		strings.Join(placeholders, ", "),
+	)
+	_, err := d.exec(query, args...)
+	return err
+}
+
+func (d *sqlStorage) Update(sObjectType string, id string, values map[string]interface{}) error {
+	fields := []string{}
+	args := []interface{}{}
+	for name, value := range values {
+		fields = append(fields, fmt.Sprintf("%s = ?", d.dialect.Quote(name)))
+		args = append(args, value)
+	}
+	query := fmt.Sprintf(
+		"UPDATE %s SET %s WHERE %s = ?",
+		d.dialect.Quote(sObjectType),
+		strings.Join(fields, ", "),
+		d.dialect.Quote("Id"),
+	)
+	_, err := d.exec(query, append(args, id)...)
+	return err
+}
+
+func (d *sqlStorage) Delete(sObjectType string, id string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE %s = ?", d.dialect.Quote(sObjectType), d.dialect.Quote("Id"))
+	_, err := d.exec(query, id)
+	return err
+}
+
+// FindRecords returns the records whose field has one of the values
+func (d *sqlStorage) FindRecords(sObjectType string, field string, values []string) ([]*ast.Object, error) {
+	defer measureStorageTime(time.Now())
+	records := []*ast.Object{}
+	if len(values) == 0 {
+		return records, nil
+	}
+	placeholders := make([]string, len(values))
+	args := make([]interface{}, len(values))
+	for i, value := range values {
+		placeholders[i] = "?"
+		args[i] = value
+	}
+	query := fmt.Sprintf(
+		"SELECT * FROM %s WHERE %s IN (%s)",
+		d.dialect.Quote(sObjectType),
+		d.dialect.Quote(field),
+		strings.Join(placeholders, ", "),
+	)
+	rows, err := d.query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	classType, _ := PrimitiveClassMap().Get(sObjectType)
+	for rows.Next() {
+		dispatches := make([]interface{}, len(columns))
+		for i := range columns {
+			dispatches[i] = &sql.NullString{}
 		}
-		saveResults[i] = obj
+		if err := rows.Scan(dispatches...); err != nil {
+			return nil, err
+		}
+		record := ast.CreateObject(classType)
+		for i, column := range columns {
+			record.InstanceFields.Set(column, newNullableFieldValue(sObjectType, column, dispatches[i].(*sql.NullString)))
+		}
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// fieldArg returns the argument of the field value in SQL, null is NULL
+func fieldArg(value *ast.Object) interface{} {
+	if value == Null {
+		return nil
+	}
+	return fieldText(value)
+}
+
+// newNullableFieldValue converts the column value to the value of the field type, NULL is null
+func newNullableFieldValue(sObjectType, fieldName string, value *sql.NullString) *ast.Object {
+	if !value.Valid {
+		return Null
 	}
-	listObject := ast.CreateObject(ListType)
-	listObject.Extra["records"] = saveResults
-	return listObject
+	return newFieldValue(sObjectType, fieldName, value.String)
 }
 
-func (d *databaseDriver) ExecuteRaw(query string, args ...interface{}) error {
-	_, err := d.db.Exec(query, args...)
+func (d *sqlStorage) ExecuteRaw(query string, args ...interface{}) error {
+	_, err := d.exec(query, args...)
 	return err
 }
 
@@ -180,16 +389,16 @@ var dbTypeMapper = map[string]string{
 	"multipicklist":              "TEXT",
 	"combobox":                   "TEXT",
 	"reference":                  "TEXT",
-	"boolean":                    "INT",
-	"currency":                   "REAL",
+	"boolean":                    "BOOLEAN",
+	"currency":                   "DOUBLE PRECISION",
 	"textarea":                   "TEXT",
-	"int":                        "INT",
-	"double":                     "REAL",
-	"percent":                    "REAL",
+	"int":                        "INTEGER",
+	"double":                     "DOUBLE PRECISION",
+	"percent":                    "DOUBLE PRECISION",
 	"id":                         "TEXT",
-	"date":                       "TEXT",
-	"datetime":                   "TEXT",
-	"time":                       "TEXT",
+	"date":                       "DATE",
+	"datetime":                   "TIMESTAMP",
+	"time":                       "TIME",
 	"url":                        "TEXT",
 	"email":                      "TEXT",
 	"encryptedstring":            "TEXT",
@@ -208,26 +417,59 @@ func CreateDatabase(src string) error {
 		return err
 	}
 	for name, sobject := range sobjects {
-		fields := make([]string, len(sobject.Fields))
-		for i, field := range sobject.Fields {
-			if field.Name == "id" {
-				fields[i] = "id VARCHAR NOT NULL PRIMARY KEY"
-			} else {
-				if _, ok := dbTypeMapper[field.Type]; !ok {
-					return fmt.Errorf("undefined type mapper %s", field.Type)
-				}
-				fields[i] = fmt.Sprintf("`%s` %s", field.Name, dbTypeMapper[field.Type])
-			}
+		if err := DatabaseDriver.CreateTable(name, sobject); err != nil {
+			return err
 		}
-		query := fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s` (%s);", name, strings.Join(fields, ", "))
-		err := DatabaseDriver.ExecuteRaw(query)
-		if err != nil {
+	}
+	return nil
+}
+
+// CreateTables creates the tables of the loaded sObjects which do not exist yet,
+// which is required for the in-memory database as it is empty on open
+func CreateTables() error {
+	for name, sobject := range sObjects {
+		if err := DatabaseDriver.CreateTable(name, sobject); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+func (d *sqlStorage) CreateTable(name string, sobject Sobject) error {
+	return d.createTable(name, sobject, false)
+}
+
+// Isolate creates the empty temporary tables of the sObjects, which hide the tables of the same names.
+// The temporary tables are dropped on Rollback as they are created in the transaction.
+func (d *sqlStorage) Isolate() error {
+	for name, sobject := range loadedSObjects() {
+		if err := d.createTable(name, sobject, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *sqlStorage) createTable(name string, sobject Sobject, temporary bool) error {
+	fields := make([]string, len(sobject.Fields))
+	for i, field := range sobject.Fields {
+		if field.Name == "id" {
+			fields[i] = "id VARCHAR NOT NULL PRIMARY KEY"
+		} else {
+			if _, ok := dbTypeMapper[field.Type]; !ok {
+				return fmt.Errorf("undefined type mapper %s", field.Type)
+			}
+			fields[i] = fmt.Sprintf("%s %s", d.dialect.Quote(field.Name), dbTypeMapper[field.Type])
+		}
+	}
+	table := "TABLE"
+	if temporary {
+		table = "TEMPORARY TABLE"
+	}
+	query := fmt.Sprintf("CREATE %s IF NOT EXISTS %s (%s);", table, d.dialect.Quote(name), strings.Join(fields, ", "))
+	return d.ExecuteRaw(query)
+}
+
 func Seed(username, password, endpoint, src string) error {
 	loader := NewMetaFileLoader(src)
 	sobjects, err := loader.Load()
@@ -249,19 +491,19 @@ func Seed(username, password, endpoint, src string) error {
 			insertFields := make([]string, len(record.Fields)+1)
 			insertValues := make([]interface{}, len(record.Fields)+1)
 			placeholders := make([]string, len(record.Fields)+1)
-			insertFields[0] = "`id`"
-			insertValues[0] = "'" + record.Id + "'"
+			insertFields[0] = DatabaseDriver.Quote("id")
+			insertValues[0] = record.Id
 			placeholders[0] = "?"
 			i := 1
 			for key, insertField := range record.Fields {
-				insertFields[i] = "`" + key + "`"
-				insertValues[i] = "'" + insertField.(string) + "'"
+				insertFields[i] = DatabaseDriver.Quote(key)
+				insertValues[i] = insertField.(string)
 				placeholders[i] = "?"
 				i++
 			}
 			query := fmt.Sprintf(
-				"INSERT INTO `%s`(%s) VALUES (%s);",
-				name,
+				"INSERT INTO %s(%s) VALUES (%s);",
+				DatabaseDriver.Quote(name),
 				strings.Join(insertFields, ", "),
 				strings.Join(placeholders, ", "),
 			)
@@ -0,0 +1,312 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// StatusCodeType is the code of the error of the record failed in DML, which is returned by Database.Error#getStatusCode
+var StatusCodeType = CreateEnumType("StatusCode", []string{
+	"DUPLICATE_EXTERNAL_ID",
+	"DUPLICATE_VALUE",
+	"ENTITY_IS_DELETED",
+	"FIELD_CUSTOM_VALIDATION_EXCEPTION",
+	"FIELD_INTEGRITY_EXCEPTION",
+	"INVALID_CROSS_REFERENCE_KEY",
+	"INVALID_FIELD_FOR_INSERT_UPDATE",
+	"MISSING_ARGUMENT",
+	"REQUIRED_FIELD_MISSING",
+})
+
+// DmlError is the error of the record failed in DML, which is Database.Error in Apex
+type DmlError struct {
+	StatusCode string
+	Message    string
+	Fields     []string
+}
+
+// DmlFailure is the record failed in DML, Index is the row in the records of the DML.
+// Id is null if the record is not saved yet.
+type DmlFailure struct {
+	Index  int
+	Id     *ast.Object
+	Errors []*DmlError
+}
+
+var databaseErrorType = ast.CreateClass(
+	"Error",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var saveResultType = newDmlResultType("SaveResult")
+var upsertResultType = newDmlResultType("UpsertResult")
+var deleteResultType = newDmlResultType("DeleteResult")
+var undeleteResultType = newDmlResultType("UndeleteResult")
+
+// dmlOptionsType is Database.DMLOptions, whose optAllOrNone is false unless it is set.
+// allowFieldTruncation is accepted, but has no effect as the field lengths are not checked.
+var dmlOptionsType = ast.CreateClass(
+	"DMLOptions",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var dmlOptionsTypeParameter = &ast.Parameter{
+	Type: dmlOptionsType,
+	Name: "_",
+}
+
+func newDmlResultType(name string) *ast.ClassType {
+	instanceMethods := ast.NewMethodMap()
+	instanceMethods.Set(
+		"getErrors",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getErrors",
+				CreateListType(databaseErrorType),
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return this.Extra["errors"]
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getId",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getId",
+				IdType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return this.Extra["id"]
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"isSuccess",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"isSuccess",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return this.Extra["isSuccess"]
+				},
+			),
+		},
+	)
+	if name == "UpsertResult" {
+		instanceMethods.Set(
+			"isCreated",
+			[]*ast.Method{
+				ast.CreateMethod(
+					"isCreated",
+					BooleanType,
+					[]*ast.Parameter{},
+					func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+						return this.Extra["isCreated"]
+					},
+				),
+			},
+		)
+	}
+	return ast.CreateClass(
+		name,
+		[]*ast.Method{},
+		instanceMethods,
+		ast.NewMethodMap(),
+	)
+}
+
+// dmlResultType returns the type of the result of the record by the dml type, such as Database.UpsertResult of upsert
+func dmlResultType(dmlType string) *ast.ClassType {
+	switch dmlType {
+	case "upsert":
+		return upsertResultType
+	case "delete":
+		return deleteResultType
+	case "undelete":
+		return undeleteResultType
+	}
+	return saveResultType
+}
+
+// NewDmlResult creates the result of the record returned by Database DML methods, which is successful if errors are empty.
+// Id is null if the record failed to be inserted, created is whether the record is inserted by upsert.
+func NewDmlResult(dmlType string, id *ast.Object, created bool, errors []*DmlError) *ast.Object {
+	obj := ast.CreateObject(dmlResultType(dmlType))
+	obj.Extra["id"] = id
+	obj.Extra["isSuccess"] = NewBoolean(len(errors) == 0)
+	obj.Extra["isCreated"] = NewBoolean(created)
+	errorObjects := make([]*ast.Object, len(errors))
+	for i, err := range errors {
+		errorObjects[i] = newDatabaseError(err)
+	}
+	obj.Extra["errors"] = &ast.Object{
+		ClassType:      CreateListType(databaseErrorType),
+		InstanceFields: ast.NewObjectMap(),
+		Extra: map[string]interface{}{
+			"records": errorObjects,
+		},
+	}
+	return obj
+}
+
+// NewDmlResultList creates the list of the results returned by Database DML methods for the list of the records
+func NewDmlResultList(dmlType string, results []*ast.Object) *ast.Object {
+	return &ast.Object{
+		ClassType:      CreateListType(dmlResultType(dmlType)),
+		InstanceFields: ast.NewObjectMap(),
+		Extra: map[string]interface{}{
+			"records": results,
+		},
+	}
+}
+
+func newDatabaseError(err *DmlError) *ast.Object {
+	obj := ast.CreateObject(databaseErrorType)
+	obj.Extra["error"] = err
+	return obj
+}
+
+// NewDmlException creates DmlException of the failed records, whose message is the first error of the first record such as
+// Insert failed. First exception on row 0; first error: REQUIRED_FIELD_MISSING, Required fields are missing: [Name]: [Name]
+func NewDmlException(dmlType string, failures []*DmlFailure) *ast.Object {
+	first := failures[0]
+	withId := ""
+	if first.Id != nil && first.Id != Null {
+		withId = " with id " + first.Id.StringValue()
+	}
+	err := first.Errors[0]
+	message := fmt.Sprintf(
+		"%s failed. First exception on row %d%s; first error: %s, %s: [%s]",
+		strings.Title(dmlType),
+		first.Index,
+		withId,
+		err.StatusCode,
+		err.Message,
+		strings.Join(err.Fields, ", "),
+	)
+	exception := NewException(DmlExceptionType, message)
+	exception.Extra["failures"] = failures
+	return exception
+}
+
+// dmlFailures returns the failed records of DmlException, which are empty if the exception is created in Apex
+func dmlFailures(exception *ast.Object) []*DmlFailure {
+	if failures, ok := exception.Extra["failures"].([]*DmlFailure); ok {
+		return failures
+	}
+	return []*DmlFailure{}
+}
+
+// dmlExceptionMethod creates the method of DmlException, which returns the value of the i-th failed record
+func dmlExceptionMethod(name string, returnType *ast.ClassType, value func(*DmlFailure) *ast.Object) []*ast.Method {
+	return []*ast.Method{
+		ast.CreateMethod(
+			name,
+			returnType,
+			[]*ast.Parameter{IntegerTypeParameter},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				failures := dmlFailures(this)
+				i := params[0].IntegerValue()
+				if i < 0 || i >= len(failures) {
+					return CreateRaise(NewException(ListExceptionType, fmt.Sprintf("List index out of bounds: %d", i)))
+				}
+				return value(failures[i])
+			},
+		),
+	}
+}
+
+func init() {
+	databaseErrorType.InstanceMethods.Set(
+		"getStatusCode",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getStatusCode",
+				StatusCodeType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return EnumValueOf(StatusCodeType, this.Extra["error"].(*DmlError).StatusCode)
+				},
+			),
+		},
+	)
+	databaseErrorType.InstanceMethods.Set(
+		"getMessage",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getMessage",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["error"].(*DmlError).Message)
+				},
+			),
+		},
+	)
+	databaseErrorType.InstanceMethods.Set(
+		"getFields",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getFields",
+				CreateListType(StringType),
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return newStringList(this.Extra["error"].(*DmlError).Fields)
+				},
+			),
+		},
+	)
+
+	dmlOptionsType.InstanceFields.Set("optAllOrNone", ast.CreateField("optAllOrNone", BooleanType))
+	dmlOptionsType.InstanceFields.Set("allowFieldTruncation", ast.CreateField("allowFieldTruncation", BooleanType))
+
+	DmlExceptionType.InstanceMethods.Set(
+		"getNumDml",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getNumDml",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(len(dmlFailures(this)))
+				},
+			),
+		},
+	)
+	DmlExceptionType.InstanceMethods.Set("getDmlId", dmlExceptionMethod("getDmlId", IdType, func(failure *DmlFailure) *ast.Object {
+		if failure.Id == nil {
+			return Null
+		}
+		return failure.Id
+	}))
+	DmlExceptionType.InstanceMethods.Set("getDmlIndex", dmlExceptionMethod("getDmlIndex", IntegerType, func(failure *DmlFailure) *ast.Object {
+		return NewInteger(failure.Index)
+	}))
+	DmlExceptionType.InstanceMethods.Set("getDmlMessage", dmlExceptionMethod("getDmlMessage", StringType, func(failure *DmlFailure) *ast.Object {
+		return NewString(failure.Errors[0].Message)
+	}))
+	DmlExceptionType.InstanceMethods.Set("getDmlFieldNames", dmlExceptionMethod("getDmlFieldNames", CreateListType(StringType), func(failure *DmlFailure) *ast.Object {
+		return newStringList(failure.Errors[0].Fields)
+	}))
+	DmlExceptionType.InstanceMethods.Set("getDmlType", dmlExceptionMethod("getDmlType", StatusCodeType, func(failure *DmlFailure) *ast.Object {
+		return EnumValueOf(StatusCodeType, failure.Errors[0].StatusCode)
+	}))
+}
+
+func newStringList(values []string) *ast.Object {
+	records := make([]*ast.Object, len(values))
+	for i, value := range values {
+		records[i] = NewString(value)
+	}
+	return CreateListObject(StringType, records)
+}
@@ -0,0 +1,95 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// ValidateRecords returns the errors of the records to be saved, in the order of the records.
+// The error is returned if the referred records can not be read from the storage.
+// The records to be inserted must have the required fields of the sObject, which the records to be updated can not set to null,
+// and the reference fields of the records to be inserted or updated must refer to the saved records.
+func ValidateRecords(dmlType, sObjectType string, records []*ast.Object) ([][]*DmlError, error) {
+	errors := make([][]*DmlError, len(records))
+	sObject, ok := findSObject(sObjectType)
+	if !ok || (dmlType != "insert" && dmlType != "update") {
+		return errors, nil
+	}
+	// the null fields of the records to be updated are not updated unless they are set
+	for i, record := range records {
+		if missing := missingFields(sObject, dmlType, record); len(missing) > 0 {
+			errors[i] = append(errors[i], &DmlError{
+				StatusCode: "REQUIRED_FIELD_MISSING",
+				Message:    fmt.Sprintf("Required fields are missing: [%s]", strings.Join(missing, ", ")),
+				Fields:     missing,
+			})
+		}
+	}
+	for _, field := range sObject.Fields {
+		if field.Type != "reference" || !isReferenceLoaded(field) {
+			continue
+		}
+		ids := []string{}
+		for _, record := range records {
+			if value, ok := record.InstanceFields.Get(field.Name); ok && value != Null {
+				ids = append(ids, value.StringValue())
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		saved := map[string]bool{}
+		for _, referenceTo := range field.ReferenceTo {
+			referred, err := DatabaseDriver.FindRecords(referenceTo, "Id", ids)
+			if err != nil {
+				return nil, err
+			}
+			for _, record := range referred {
+				id, _ := record.InstanceFields.Get("Id")
+				saved[id.StringValue()] = true
+			}
+		}
+		for i, record := range records {
+			if value, ok := record.InstanceFields.Get(field.Name); ok && value != Null && !saved[value.StringValue()] {
+				errors[i] = append(errors[i], &DmlError{
+					StatusCode: "INVALID_CROSS_REFERENCE_KEY",
+					Message:    "invalid cross reference id",
+					Fields:     []string{field.Name},
+				})
+			}
+		}
+	}
+	return errors, nil
+}
+
+// missingFields returns the required fields of the sObject which the record does not have,
+// the record to be updated misses the required fields only if they are set to null
+func missingFields(sObject Sobject, dmlType string, record *ast.Object) []string {
+	missing := []string{}
+	for _, field := range sObject.Fields {
+		if !field.Required {
+			continue
+		}
+		value, ok := record.InstanceFields.Get(field.Name)
+		if dmlType == "update" && (!ok || !isSavedField(record, field.Name, value)) {
+			continue
+		}
+		if !ok || value == Null {
+			missing = append(missing, field.Name)
+		}
+	}
+	return missing
+}
+
+// isReferenceLoaded returns whether all sObjects referred by the field are loaded,
+// otherwise the references can not be validated as the records are not saved in the storage
+func isReferenceLoaded(field SobjectField) bool {
+	for _, referenceTo := range field.ReferenceTo {
+		if _, ok := findSObject(referenceTo); !ok {
+			return false
+		}
+	}
+	return len(field.ReferenceTo) > 0
+}
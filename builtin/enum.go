@@ -0,0 +1,121 @@
+package builtin
+
+import (
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// CreateEnumType creates enum class, whose constants are created at once
+// and shared as static fields in the order of declaration
+func CreateEnumType(name string, identifiers []string) *ast.ClassType {
+	classType := &ast.ClassType{
+		Name:           name,
+		Modifiers:      []*ast.Modifier{ast.PublicModifier()},
+		Enum:           true,
+		InstanceFields: ast.NewFieldMap(),
+		StaticFields:   ast.NewFieldMap(),
+		InnerClasses:   ast.NewClassMap(),
+		Extra:          map[string]interface{}{},
+		ToString: func(o *ast.Object) string {
+			return o.Extra["name"].(string)
+		},
+	}
+
+	values := make([]*ast.Object, len(identifiers))
+	for i, identifier := range identifiers {
+		value := ast.CreateObject(classType)
+		value.Extra["name"] = identifier
+		value.Extra["ordinal"] = i
+		values[i] = value
+
+		classType.StaticFields.Set(identifier, &ast.Field{
+			Name:      identifier,
+			Modifiers: []*ast.Modifier{ast.PublicModifier(), {Name: "static"}, {Name: "final"}},
+			Type:      classType,
+		})
+	}
+	classType.Extra["values"] = values
+
+	instanceMethods := ast.NewMethodMap()
+	instanceMethods.Set(
+		"name",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"name",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["name"].(string))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"ordinal",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"ordinal",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(this.Extra["ordinal"].(int))
+				},
+			),
+		},
+	)
+	classType.InstanceMethods = instanceMethods
+
+	staticMethods := ast.NewMethodMap()
+	staticMethods.Set(
+		"values",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"values",
+				CreateListType(classType),
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records := make([]*ast.Object, len(values))
+					copy(records, values)
+					return CreateListObject(classType, records)
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"valueOf",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"valueOf",
+				classType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return Null
+					}
+					if value := EnumValueOf(classType, params[0].StringValue()); value != nil {
+						return value
+					}
+					return Null
+				},
+			),
+		},
+	)
+	classType.StaticMethods = staticMethods
+	return classType
+}
+
+// EnumValues returns the constants of the enum in the order of declaration
+func EnumValues(classType *ast.ClassType) []*ast.Object {
+	return classType.Extra["values"].([]*ast.Object)
+}
+
+// EnumValueOf returns the constant of the enum by name, or nil if not exists
+func EnumValueOf(classType *ast.ClassType, name string) *ast.Object {
+	for _, value := range EnumValues(classType) {
+		if strings.EqualFold(value.Extra["name"].(string), name) {
+			return value
+		}
+	}
+	return nil
+}
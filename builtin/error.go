@@ -1,9 +1,11 @@
 package builtin
 
-import "github.com/tzmfreedom/land/ast"
+import (
+	"github.com/tzmfreedom/land/ast"
+)
 
 type NullPointerException struct {
-	name string
+	name     string
 	location *ast.Location
 }
 
@@ -18,3 +20,24 @@ func (e *NullPointerException) Error() string {
 func (e *NullPointerException) GetName() string {
 	return e.name
 }
+
+// RaiseError carries a thrown exception object while it propagates through
+// expressions, which can not return Raise objects as statements do.
+type RaiseError struct {
+	Exception *ast.Object
+}
+
+func NewRaiseError(exception *ast.Object) *RaiseError {
+	return &RaiseError{Exception: exception}
+}
+
+func (e *RaiseError) Error() string {
+	str := ExceptionTypeName(e.Exception.ClassType)
+	if message := exceptionExtra(e.Exception, "message"); message != Null {
+		str += ": " + message.StringValue()
+	}
+	if stackTrace, ok := e.Exception.Extra["stackTrace"].(string); ok && stackTrace != "" {
+		str += "\n" + stackTrace
+	}
+	return str
+}
@@ -6,13 +6,81 @@ import (
 	"github.com/tzmfreedom/land/ast"
 )
 
-var ExceptionType = &ast.ClassType{}
+var ExceptionType = &ast.ClassType{Name: "Exception"}
 
 var exceptionTypeParameter = &ast.Parameter{
 	Type: ExceptionType,
 	Name: "_",
 }
 
+var DmlExceptionType = newExceptionType("DmlException")
+var QueryExceptionType = newExceptionType("QueryException")
+var NullPointerExceptionType = newExceptionType("NullPointerException")
+var ListExceptionType = newExceptionType("ListException")
+var MathExceptionType = newExceptionType("MathException")
+var TypeExceptionType = newExceptionType("TypeException")
+var JSONExceptionType = newExceptionType("JSONException")
+var CalloutExceptionType = newExceptionType("CalloutException")
+var StringExceptionType = newExceptionType("StringException")
+var LimitExceptionType = newExceptionType("LimitException")
+var FinalExceptionType = newExceptionType("FinalException")
+var AsyncExceptionType = newExceptionType("AsyncException")
+
+var systemExceptionTypes = []*ast.ClassType{
+	ExceptionType,
+	DmlExceptionType,
+	QueryExceptionType,
+	NullPointerExceptionType,
+	ListExceptionType,
+	MathExceptionType,
+	TypeExceptionType,
+	JSONExceptionType,
+	CalloutExceptionType,
+	StringExceptionType,
+	LimitExceptionType,
+	FinalExceptionType,
+	AsyncExceptionType,
+}
+
+func newExceptionType(name string) *ast.ClassType {
+	return &ast.ClassType{
+		Name:            name,
+		Modifiers:       []*ast.Modifier{ast.PublicModifier(), {Name: "virtual"}},
+		SuperClass:      ExceptionType,
+		Constructors:    []*ast.Method{},
+		InstanceFields:  ast.NewFieldMap(),
+		StaticFields:    ast.NewFieldMap(),
+		InstanceMethods: ast.NewMethodMap(),
+		StaticMethods:   ast.NewMethodMap(),
+		InnerClasses:    ast.NewClassMap(),
+	}
+}
+
+// IsExceptionType reports whether classType is Exception or one of its subclasses
+func IsExceptionType(classType *ast.ClassType) bool {
+	for t := classType; t != nil; t = t.SuperClass {
+		if t == ExceptionType {
+			return true
+		}
+	}
+	return false
+}
+
+// NewException creates an exception object as if `new classType(message)` is called
+func NewException(classType *ast.ClassType, message string) *ast.Object {
+	obj := ast.CreateObject(classType)
+	obj.Extra["message"] = NewString(message)
+	obj.Extra["cause"] = Null
+	return obj
+}
+
+func exceptionExtra(this *ast.Object, key string) *ast.Object {
+	if value, ok := this.Extra[key].(*ast.Object); ok {
+		return value
+	}
+	return Null
+}
+
 func createExceptionType() {
 	instanceMethods := ast.NewMethodMap()
 	instanceMethods.Set(
@@ -23,19 +91,107 @@ func createExceptionType() {
 				StringType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					return this.Extra["message"]
+					return exceptionExtra(this, "message")
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setMessage",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setMessage",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["message"] = params[0]
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getCause",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getCause",
+				ExceptionType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return exceptionExtra(this, "cause")
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"initCause",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"initCause",
+				nil,
+				[]*ast.Parameter{exceptionTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["cause"] = params[0]
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getLineNumber",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getLineNumber",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if line, ok := this.Extra["lineNumber"].(int); ok {
+						return NewInteger(line)
+					}
+					return NewInteger(-1)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getStackTraceString",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getStackTraceString",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if stackTrace, ok := this.Extra["stackTrace"].(string); ok {
+						return NewString(stackTrace)
+					}
+					return NewString("")
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getTypeName",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getTypeName",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(ExceptionTypeName(this.ClassType))
 				},
 			),
 		},
 	)
 
+	// user defined exceptions do not declare these constructors,
+	// they are found through the SuperClass chain on construction
 	ExceptionType.Constructors = []*ast.Method{
 		{
 			Modifiers:  []*ast.Modifier{ast.PublicModifier()},
 			Parameters: []*ast.Parameter{},
 			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 				this.Extra["message"] = Null
-				this.Extra["exception"] = Null
+				this.Extra["cause"] = Null
 				return nil
 			},
 		},
@@ -44,7 +200,7 @@ func createExceptionType() {
 			Parameters: []*ast.Parameter{stringTypeParameter},
 			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 				this.Extra["message"] = params[0]
-				this.Extra["exception"] = Null
+				this.Extra["cause"] = Null
 				return nil
 			},
 		},
@@ -52,22 +208,59 @@ func createExceptionType() {
 			Modifiers:  []*ast.Modifier{ast.PublicModifier()},
 			Parameters: []*ast.Parameter{exceptionTypeParameter},
 			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				cause := params[0]
 				this.Extra["message"] = Null
-				this.Extra["exception"] = params[0]
+				if cause != Null {
+					this.Extra["message"] = NewString(ExceptionTypeName(cause.ClassType) + ": " + String(exceptionExtra(cause, "message")))
+				}
+				this.Extra["cause"] = cause
+				return nil
+			},
+		},
+		{
+			Modifiers: []*ast.Modifier{ast.PublicModifier()},
+			Parameters: []*ast.Parameter{
+				stringTypeParameter,
+				exceptionTypeParameter,
+			},
+			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				this.Extra["message"] = params[0]
+				this.Extra["cause"] = params[1]
 				return nil
 			},
 		},
 	}
+	ExceptionType.Modifiers = []*ast.Modifier{ast.PublicModifier(), {Name: "virtual"}}
 	ExceptionType.InstanceFields = ast.NewFieldMap()
 	ExceptionType.StaticFields = ast.NewFieldMap()
 	ExceptionType.InstanceMethods = instanceMethods
 	ExceptionType.StaticMethods = ast.NewMethodMap()
+	ExceptionType.InnerClasses = ast.NewClassMap()
 	ExceptionType.ToString = func(o *ast.Object) string {
-		return fmt.Sprintf("<%s> { message => %s } ", ExceptionType.Name, String(o.Extra["message"].(*ast.Object)))
+		return fmt.Sprintf("<%s> { message => %s } ", o.ClassType.Name, String(exceptionExtra(o, "message")))
+	}
+	for _, t := range systemExceptionTypes[1:] {
+		t.ToString = ExceptionType.ToString
 	}
 }
 
+// ExceptionTypeName returns the name returned by Exception#getTypeName,
+// which is qualified by the System namespace for built-in exceptions
+func ExceptionTypeName(classType *ast.ClassType) string {
+	for _, t := range systemExceptionTypes {
+		if t == classType {
+			return "System." + t.Name
+		}
+	}
+	return classType.Name
+}
+
 func init() {
 	createExceptionType()
-	primitiveClassMap.Set("Exception", ExceptionType)
+	classMap := ast.NewClassMap()
+	for _, t := range systemExceptionTypes {
+		primitiveClassMap.Set(t.Name, t)
+		classMap.Set(t.Name, t)
+	}
+	nameSpaceStore.Set("System", classMap)
 }
@@ -0,0 +1,112 @@
+package builtin
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// the layouts of Date, Datetime and Time saved in the storage, Datetime is saved in GMT
+const (
+	dateLayout     = "2006-01-02"
+	datetimeLayout = "2006-01-02 15:04:05"
+	timeLayout     = "15:04:05.000"
+)
+
+// datetimeLayouts parse the datetime read from the database,
+// the drivers return the values of the date and datetime columns in RFC 3339
+var datetimeLayouts = []string{
+	datetimeLayout,
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+}
+
+var timeLayouts = []string{
+	timeLayout,
+	"15:04:05",
+	time.RFC3339Nano,
+}
+
+// fieldType returns the type of the field in the metafile, or empty if the field is not found
+func fieldType(sObjectType, fieldName string) string {
+	sobject, ok := findSObject(sObjectType)
+	if !ok {
+		return ""
+	}
+	for _, field := range sobject.Fields {
+		if strings.EqualFold(field.Name, fieldName) {
+			return field.Type
+		}
+	}
+	return ""
+}
+
+// newFieldValue converts the text saved in the storage to the value of the field type,
+// the text is returned as String if it can not be converted
+func newFieldValue(sObjectType, fieldName, value string) *ast.Object {
+	switch typeMapper[fieldType(sObjectType, fieldName)] {
+	case IdType:
+		return NewId(value)
+	case BooleanType:
+		return NewBoolean(value == "true" || value == "1" || value == "t")
+	case IntegerType:
+		if i, err := strconv.Atoi(value); err == nil {
+			return NewInteger(i)
+		}
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return NewInteger(int(f))
+		}
+	case DoubleType:
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return NewDouble(f)
+		}
+	case DateType:
+		if len(value) >= len(dateLayout) {
+			if t, err := time.Parse(dateLayout, value[:len(dateLayout)]); err == nil {
+				return newTimeValue(DateType, t)
+			}
+		}
+	case DatetimeType:
+		for _, layout := range datetimeLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				return newTimeValue(DatetimeType, t.UTC())
+			}
+		}
+	case timeType:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				// the date of Time is the same as Time.newInstance
+				return newTimeValue(timeType, time.Date(2020, time.Month(1), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC))
+			}
+		}
+	}
+	return NewString(value)
+}
+
+func newTimeValue(classType *ast.ClassType, t time.Time) *ast.Object {
+	obj := ast.CreateObject(classType)
+	obj.Extra["value"] = t
+	return obj
+}
+
+// fieldText converts the value of the field to the text saved in the storage
+func fieldText(value *ast.Object) string {
+	switch value.ClassType {
+	case IdType:
+		// the Ids are saved in 18 characters
+		if id := value.StringValue(); ValidId(id) {
+			return To18(id)
+		}
+	case DoubleType:
+		return strconv.FormatFloat(value.Value().(float64), 'f', -1, 64)
+	case DateType:
+		return value.Value().(time.Time).Format(dateLayout)
+	case DatetimeType:
+		return value.Value().(time.Time).UTC().Format(datetimeLayout)
+	case timeType:
+		return value.Value().(time.Time).Format(timeLayout)
+	}
+	return String(value)
+}
@@ -0,0 +1,55 @@
+package builtin
+
+import (
+	"testing"
+)
+
+func TestFieldValue(t *testing.T) {
+	prev := sObjects
+	sObjects = map[string]Sobject{
+		"Account": {
+			Name: "Account",
+			Fields: []SobjectField{
+				{Name: "Id", Type: "id"},
+				{Name: "Name", Type: "string"},
+				{Name: "IsDeleted", Type: "boolean"},
+				{Name: "NumberOfEmployees", Type: "int"},
+				{Name: "AnnualRevenue", Type: "currency"},
+				{Name: "LastActivityDate", Type: "date"},
+				{Name: "CreatedDate", Type: "datetime"},
+				{Name: "OpenTime", Type: "time"},
+			},
+		},
+	}
+	defer func() {
+		sObjects = prev
+	}()
+	testCases := []struct {
+		Field    string
+		Text     string
+		Type     string
+		Expected string // the text saved again
+	}{
+		{"Id", "001A0000006Vm9r", "Id", "001A0000006Vm9rIAC"},
+		{"Name", "Acme", "String", "Acme"},
+		{"IsDeleted", "true", "Boolean", "true"},
+		{"IsDeleted", "0", "Boolean", "false"},
+		{"NumberOfEmployees", "120", "Integer", "120"},
+		{"AnnualRevenue", "1500.5", "Double", "1500.5"},
+		{"LastActivityDate", "2024-03-15", "Date", "2024-03-15"},
+		{"LastActivityDate", "2024-03-15T00:00:00Z", "Date", "2024-03-15"},
+		{"CreatedDate", "2024-03-15 10:20:30", "Datetime", "2024-03-15 10:20:30"},
+		{"CreatedDate", "2024-03-15T19:20:30+09:00", "Datetime", "2024-03-15 10:20:30"},
+		{"OpenTime", "09:30:00.250", "Time", "09:30:00.250"},
+		{"NumberOfEmployees", "many", "String", "many"},
+	}
+	for _, testCase := range testCases {
+		value := newFieldValue("Account", testCase.Field, testCase.Text)
+		if value.ClassType.Name != testCase.Type {
+			t.Errorf("%s %s: expected %s, actual %s", testCase.Field, testCase.Text, testCase.Type, value.ClassType.Name)
+		}
+		if actual := fieldText(value); actual != testCase.Expected {
+			t.Errorf("%s %s: expected %s, actual %s", testCase.Field, testCase.Text, testCase.Expected, actual)
+		}
+	}
+}
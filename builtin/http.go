@@ -1,14 +1,85 @@
 package builtin
 
 import (
+	"bytes"
+	"compress/gzip"
+	"crypto/tls"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
-
-	"strings"
+	"time"
 
 	"github.com/tzmfreedom/land/ast"
 )
 
+// sendHttpRequest sends the request to the endpoint,
+// network failures and timeout are raised as CalloutException
+func sendHttpRequest(request *ast.Object) interface{} {
+	endpoint, credentialHeaders, err := ResolveEndpoint(request.Extra["endpoint"].(string))
+	if err != nil {
+		return CreateRaise(NewException(CalloutExceptionType, err.Error()))
+	}
+	headers := map[string]string{}
+	for header, value := range credentialHeaders {
+		headers[header] = value
+	}
+	var body io.Reader = bytes.NewReader(request.Extra["body"].([]byte))
+	if request.Extra["compressed"].(bool) {
+		buf := new(bytes.Buffer)
+		writer := gzip.NewWriter(buf)
+		writer.Write(request.Extra["body"].([]byte))
+		writer.Close()
+		body = buf
+		headers["Content-Encoding"] = "gzip"
+	}
+	req, err := http.NewRequest(request.Extra["method"].(string), endpoint, body)
+	if err != nil {
+		return CreateRaise(NewException(CalloutExceptionType, err.Error()))
+	}
+	for header, value := range headers {
+		req.Header.Set(header, value)
+	}
+	for header, value := range request.Extra["headers"].(map[string]string) {
+		req.Header.Set(header, value)
+	}
+
+	transport := &http.Transport{}
+	if name, ok := request.Extra["certificate"].(string); ok {
+		certificate, err := LoadCertificate(name)
+		if err != nil {
+			return CreateRaise(NewException(CalloutExceptionType, err.Error()))
+		}
+		transport.TLSClientConfig = &tls.Config{Certificates: []tls.Certificate{certificate}}
+	}
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   time.Duration(request.Extra["timeout"].(int)) * time.Millisecond,
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return CreateRaise(NewException(CalloutExceptionType, "Read timed out"))
+		}
+		return CreateRaise(NewException(CalloutExceptionType, err.Error()))
+	}
+	defer res.Body.Close()
+	buf, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return CreateRaise(NewException(CalloutExceptionType, err.Error()))
+	}
+
+	response := newHttpResponse()
+	response.Extra["body"] = buf
+	response.Extra["statusCode"] = res.StatusCode
+	response.Extra["status"] = http.StatusText(res.StatusCode)
+	responseHeaders := response.Extra["headers"].(map[string]string)
+	for key := range res.Header {
+		responseHeaders[key] = res.Header.Get(key)
+	}
+	return response
+}
+
 func init() {
 	instanceMethods := ast.NewMethodMap()
 	staticMethods := ast.NewMethodMap()
@@ -38,30 +109,20 @@ func init() {
 					httpRequestTypeParameter,
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					request := params[0]
-					endpoint := request.Extra["endpoint"].(string)
-					method := request.Extra["method"].(string)
-					headers := request.Extra["headers"].(map[string]*ast.Object)
-					body := request.Extra["body"].(string)
-					req, err := http.NewRequest(method, endpoint, strings.NewReader(body))
-					if err != nil {
-						panic(err) // TODO: impl
+					if exception := extra["interpreter"].(LimitCounter).Limits().AddCallout(); exception != nil {
+						return CreateRaise(exception)
 					}
-					for header, value := range headers {
-						req.Header.Add(header, value.StringValue())
+					if exception := checkCalloutAllowed(extra); exception != nil {
+						return CreateRaise(exception)
 					}
-					client := &http.Client{}
-					res, err := client.Do(req)
-					if err != nil {
-						panic(err) // TODO: impl
+					request := params[0]
+					if mock, ok := extra["http_callout_mock"].(*ast.Object); ok {
+						return invokeMock(mock, "respond", []*ast.Object{request}, extra)
 					}
-					buf, err := ioutil.ReadAll(res.Body)
-					if err != nil {
-						panic(err)
+					if extra["interpreter"].(TestContext).IsRunningTest() {
+						return CreateRaise(NewException(CalloutExceptionType, calloutInTestMessage))
 					}
-					responseObj := ast.CreateObject(httpResponseType)
-					responseObj.Extra["body"] = string(buf)
-					return responseObj
+					return sendHttpRequest(request)
 				},
 			),
 		},
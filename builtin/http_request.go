@@ -6,6 +6,9 @@ import (
 	"github.com/tzmfreedom/land/ast"
 )
 
+const defaultCalloutTimeout = 10000
+const maxCalloutTimeout = 120000
+
 var httpRequestType = &ast.ClassType{Name: "HttpRequest"}
 var httpRequestTypeParameter = &ast.Parameter{
 	Type: httpRequestType,
@@ -21,7 +24,12 @@ func init() {
 			nil,
 			[]*ast.Parameter{},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-				this.Extra["headers"] = map[string]*ast.Object{}
+				this.Extra["headers"] = map[string]string{}
+				this.Extra["body"] = []byte{}
+				this.Extra["endpoint"] = ""
+				this.Extra["method"] = ""
+				this.Extra["timeout"] = defaultCalloutTimeout
+				this.Extra["compressed"] = false
 				return nil
 			},
 		),
@@ -31,71 +39,127 @@ func init() {
 	httpRequestType.InstanceMethods = instanceMethods
 	httpRequestType.StaticMethods = staticMethods
 
+	setHttpMessageMethods(instanceMethods)
 	instanceMethods.Set(
-		"setHeader",
+		"setMethod",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"setHeader",
+				"setMethod",
 				nil,
 				[]*ast.Parameter{
 					stringTypeParameter,
-					stringTypeParameter,
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					key := params[0].StringValue()
-					headers := this.Extra["headers"].(map[string]*ast.Object)
-					headers[key] = params[1]
+					method := params[0].StringValue()
+					this.Extra["method"] = strings.ToUpper(method)
 					return nil
 				},
 			),
 		},
 	)
 	instanceMethods.Set(
-		"setMethod",
+		"getMethod",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"setMethod",
+				"getMethod",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["method"].(string))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setEndpoint",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setEndpoint",
 				nil,
 				[]*ast.Parameter{
 					stringTypeParameter,
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					method := params[0].StringValue()
-					this.Extra["method"] = strings.ToUpper(method)
+					endpoint := params[0].StringValue()
+					this.Extra["endpoint"] = endpoint
 					return nil
 				},
 			),
 		},
 	)
 	instanceMethods.Set(
-		"setBody",
+		"getEndpoint",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"setBody",
+				"getEndpoint",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["endpoint"].(string))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setTimeout",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setTimeout",
 				nil,
 				[]*ast.Parameter{
-					stringTypeParameter,
+					IntegerTypeParameter,
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					body := params[0].StringValue()
-					this.Extra["body"] = body
+					timeout := params[0].IntegerValue()
+					if timeout <= 0 || timeout > maxCalloutTimeout {
+						return CreateRaise(NewException(CalloutExceptionType, "Timeout must be between 1 and 120000"))
+					}
+					this.Extra["timeout"] = timeout
 					return nil
 				},
 			),
 		},
 	)
 	instanceMethods.Set(
-		"setEndpoint",
+		"setCompressed",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"setEndpoint",
+				"setCompressed",
+				nil,
+				[]*ast.Parameter{
+					booleanTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["compressed"] = params[0].BoolValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getCompressed",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getCompressed",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(this.Extra["compressed"].(bool))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setClientCertificateName",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setClientCertificateName",
 				nil,
 				[]*ast.Parameter{
 					stringTypeParameter,
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					endpoint := params[0].StringValue()
-					this.Extra["endpoint"] = endpoint
+					this.Extra["certificate"] = params[0].StringValue()
 					return nil
 				},
 			),
@@ -1,6 +1,11 @@
 package builtin
 
-import "github.com/tzmfreedom/land/ast"
+import (
+	"sort"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
 
 var httpResponseType = &ast.ClassType{Name: "HttpResponse"}
 var httpResponseTypeParameter = &ast.Parameter{
@@ -8,6 +13,122 @@ var httpResponseTypeParameter = &ast.Parameter{
 	Name: "_",
 }
 
+func newHttpResponse() *ast.Object {
+	response := ast.CreateObject(httpResponseType)
+	initHttpResponse(response)
+	return response
+}
+
+func initHttpResponse(response *ast.Object) {
+	response.Extra["body"] = []byte{}
+	response.Extra["statusCode"] = 0
+	response.Extra["status"] = ""
+	response.Extra["headers"] = map[string]string{}
+}
+
+// getHttpHeader returns the header ignoring case of the key
+func getHttpHeader(headers map[string]string, key string) (string, bool) {
+	if value, ok := headers[key]; ok {
+		return value, true
+	}
+	for k, value := range headers {
+		if strings.EqualFold(k, key) {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// setHttpMessageMethods sets the methods for the body and the headers shared by HttpRequest and HttpResponse
+func setHttpMessageMethods(instanceMethods *ast.MethodMap) {
+	instanceMethods.Set(
+		"getBody",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getBody",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(string(this.Extra["body"].([]byte)))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setBody",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setBody",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["body"] = []byte(params[0].StringValue())
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getBodyAsBlob",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getBodyAsBlob",
+				BlobType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBlob(this.Extra["body"].([]byte))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setBodyAsBlob",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setBodyAsBlob",
+				nil,
+				[]*ast.Parameter{BlobTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["body"] = params[0].Extra["value"].([]byte)
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getHeader",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getHeader",
+				StringType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					headers := this.Extra["headers"].(map[string]string)
+					if value, ok := getHttpHeader(headers, params[0].StringValue()); ok {
+						return NewString(value)
+					}
+					return Null
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setHeader",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setHeader",
+				nil,
+				[]*ast.Parameter{stringTypeParameter, stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					headers := this.Extra["headers"].(map[string]string)
+					headers[params[0].StringValue()] = params[1].StringValue()
+					return nil
+				},
+			),
+		},
+	)
+}
+
 func init() {
 	instanceMethods := ast.NewMethodMap()
 	staticMethods := ast.NewMethodMap()
@@ -17,22 +138,89 @@ func init() {
 			nil,
 			[]*ast.Parameter{},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				initHttpResponse(this)
 				return nil
 			},
 		),
 	}
+	httpResponseType.InstanceFields = ast.NewFieldMap()
+	httpResponseType.StaticFields = ast.NewFieldMap()
 	httpResponseType.InstanceMethods = instanceMethods
 	httpResponseType.StaticMethods = staticMethods
 
+	setHttpMessageMethods(instanceMethods)
 	instanceMethods.Set(
-		"getBody",
+		"getHeaderKeys",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"getBody",
+				"getHeaderKeys",
+				CreateListType(StringType),
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					keys := []string{}
+					for key := range this.Extra["headers"].(map[string]string) {
+						keys = append(keys, key)
+					}
+					sort.Strings(keys)
+					records := make([]*ast.Object, len(keys))
+					for i, key := range keys {
+						records[i] = NewString(key)
+					}
+					return CreateListObject(CreateListType(StringType), records)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getStatusCode",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getStatusCode",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(this.Extra["statusCode"].(int))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setStatusCode",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStatusCode",
+				nil,
+				[]*ast.Parameter{IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["statusCode"] = params[0].IntegerValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getStatus",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getStatus",
 				StringType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					return NewString(this.Extra["body"].(string))
+					return NewString(this.Extra["status"].(string))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setStatus",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStatus",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["status"] = params[0].StringValue()
+					return nil
 				},
 			),
 		},
@@ -0,0 +1,298 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// IdType is the Id of a record, which is the 15 characters case-sensitive Id
+// or the 18 characters case-insensitive Id with the checksum suffix
+var IdType = &ast.ClassType{Name: "Id"}
+var IdTypeParameter = &ast.Parameter{
+	Type: IdType,
+	Name: "_",
+}
+
+// idChars are the digits of the base 62 body of Id
+const idChars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// checksumChars are the characters of the 18 characters Id suffix
+const checksumChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ012345"
+
+// standardKeyPrefixes are the key prefixes of the standard sObjects,
+// used if the metafile does not have the key prefix
+var standardKeyPrefixes = map[string]string{
+	"Account":             "001",
+	"Note":                "002",
+	"Contact":             "003",
+	"User":                "005",
+	"Opportunity":         "006",
+	"Activity":            "007",
+	"Organization":        "00D",
+	"UserRole":            "00E",
+	"Group":               "00G",
+	"OpportunityLineItem": "00k",
+	"Attachment":          "00P",
+	"Lead":                "00Q",
+	"Task":                "00T",
+	"Event":               "00U",
+	"EmailTemplate":       "00X",
+	"Profile":             "00e",
+	"Folder":              "00l",
+	"RecordType":          "012",
+	"Document":            "015",
+	"Pricebook2":          "01s",
+	"Product2":            "01t",
+	"PricebookEntry":      "01u",
+	"ApexClass":           "01p",
+	"ApexTrigger":         "01q",
+	"Asset":               "02i",
+	"ContentVersion":      "068",
+	"ContentDocument":     "069",
+	"CronJobDetail":       "08a",
+	"CronTrigger":         "08e",
+	"Case":                "500",
+	"Solution":            "501",
+	"Entitlement":         "550",
+	"Campaign":            "701",
+	"AsyncApexJob":        "707",
+	"Contract":            "800",
+	"Order":               "801",
+}
+
+// customKeyPrefix is the first key prefix of the sObjects without the known key prefix
+const customKeyPrefix = "a00"
+
+// lastIdSequence is the body of the last generated Id
+var lastIdSequence int64
+
+// NewId creates the Id of the value, the valid Id is converted to 18 characters
+// so that the Id is the same key of Map and Set whichever length it is created from
+func NewId(value string) *ast.Object {
+	if ValidId(value) {
+		value = To18(value)
+	}
+	t := ast.CreateObject(IdType)
+	t.Extra["value"] = value
+	return t
+}
+
+// ValidId reports whether the value is an Id of 15 characters,
+// or 18 characters with the correct checksum
+func ValidId(value string) bool {
+	if len(value) != 15 && len(value) != 18 {
+		return false
+	}
+	for i := 0; i < 15; i++ {
+		if strings.IndexByte(idChars, value[i]) < 0 {
+			return false
+		}
+	}
+	return len(value) == 15 || strings.EqualFold(value[15:], idChecksum(value[:15]))
+}
+
+// idChecksum returns the suffix of 18 characters Id, each character encodes
+// the positions of the upper case letters in 5 characters of the Id
+func idChecksum(id string) string {
+	suffix := make([]byte, 3)
+	for i := range suffix {
+		bits := 0
+		for j := 0; j < 5; j++ {
+			c := id[i*5+j]
+			if 'A' <= c && c <= 'Z' {
+				bits |= 1 << uint(j)
+			}
+		}
+		suffix[i] = checksumChars[bits]
+	}
+	return string(suffix)
+}
+
+// To18 converts the valid Id to 18 characters, the 18 characters Id is restored to the case of 15 characters Id
+func To18(id string) string {
+	id = To15(id)
+	return id + idChecksum(id)
+}
+
+// To15 converts the valid Id to 15 characters, the case of the characters is restored by the checksum
+func To15(id string) string {
+	if len(id) != 18 {
+		return id
+	}
+	restored := []byte(id[:15])
+	for i := 0; i < 3; i++ {
+		bits := strings.IndexByte(checksumChars, strings.ToUpper(id[15+i : 16+i])[0])
+		for j := 0; j < 5; j++ {
+			c := restored[i*5+j]
+			if bits&(1<<uint(j)) != 0 && 'a' <= c && c <= 'z' {
+				restored[i*5+j] = c - 'a' + 'A'
+			} else if bits&(1<<uint(j)) == 0 && 'A' <= c && c <= 'Z' {
+				restored[i*5+j] = c - 'A' + 'a'
+			}
+		}
+	}
+	return string(restored)
+}
+
+// IdEquals compares the values as Id, the 15 characters Id equals its 18 characters Id
+func IdEquals(value, other string) bool {
+	if ValidId(value) && ValidId(other) {
+		return To15(value) == To15(other)
+	}
+	return value == other
+}
+
+// KeyPrefix returns the first 3 characters of the Id of the sObject
+func KeyPrefix(sObjectType string) string {
+	if sobject, ok := findSObject(sObjectType); ok && sobject.KeyPrefix != "" {
+		return sobject.KeyPrefix
+	}
+	for name, prefix := range standardKeyPrefixes {
+		if strings.EqualFold(name, sObjectType) {
+			return prefix
+		}
+	}
+	return customKeyPrefix
+}
+
+// sObjectTypeOfId returns the name of the sObject whose key prefix is the prefix of the Id
+func sObjectTypeOfId(id string) (string, bool) {
+	if len(id) < 3 {
+		return "", false
+	}
+	for name := range loadedSObjects() {
+		if KeyPrefix(name) == id[:3] {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// assignKeyPrefixes sets the key prefixes of the sObjects without the key prefix in the metafile,
+// the sObjects unknown to land are numbered from a00 in the order of name
+func assignKeyPrefixes(sobjects map[string]Sobject) {
+	used := map[string]bool{}
+	names := []string{}
+	for name, sobject := range sobjects {
+		if sobject.KeyPrefix == "" {
+			sobject.KeyPrefix = standardKeyPrefixes[name]
+			sobjects[name] = sobject
+		}
+		if sobject.KeyPrefix == "" {
+			names = append(names, name)
+			continue
+		}
+		used[sobject.KeyPrefix] = true
+	}
+	sort.Strings(names)
+	sequence := strings.IndexByte(idChars, customKeyPrefix[1])*len(idChars) + strings.IndexByte(idChars, customKeyPrefix[2])
+	for _, name := range names {
+		prefix := ""
+		for prefix == "" || used[prefix] {
+			prefix = fmt.Sprintf("%c%c%c", customKeyPrefix[0], idChars[sequence/len(idChars)], idChars[sequence%len(idChars)])
+			sequence++
+		}
+		used[prefix] = true
+		sobject := sobjects[name]
+		sobject.KeyPrefix = prefix
+		sobjects[name] = sobject
+	}
+}
+
+// newRecordId generates the 18 characters Id of the record inserted into the sObject.
+// The body is the microseconds since the epoch in base 62, which increases monotonically
+// so that the Ids do not conflict with the records saved by the previous runs.
+func newRecordId(sObjectType string) string {
+	sequence := time.Now().UnixNano() / int64(time.Microsecond)
+	if sequence <= lastIdSequence {
+		sequence = lastIdSequence + 1
+	}
+	lastIdSequence = sequence
+	body := make([]byte, 12)
+	for i := len(body) - 1; i >= 0; i-- {
+		body[i] = idChars[sequence%int64(len(idChars))]
+		sequence /= int64(len(idChars))
+	}
+	return To18(KeyPrefix(sObjectType) + string(body))
+}
+
+func init() {
+	instanceMethods := ast.NewMethodMap()
+	staticMethods := ast.NewMethodMap()
+	IdType.Constructors = []*ast.Method{}
+	IdType.InstanceMethods = instanceMethods
+	IdType.StaticMethods = staticMethods
+	IdType.ToString = func(o *ast.Object) string {
+		return o.StringValue()
+	}
+
+	instanceMethods.Set(
+		"to15",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"to15",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(To15(this.StringValue()))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"to18",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"to18",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(To18(this.StringValue()))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"equals",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"equals",
+				BooleanType,
+				[]*ast.Parameter{objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					other := params[0]
+					if other == Null {
+						return NewBoolean(false)
+					}
+					return NewBoolean(IdEquals(this.StringValue(), other.StringValue()))
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"valueOf",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"valueOf",
+				IdType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return Null
+					}
+					value := params[0].StringValue()
+					if !ValidId(value) {
+						return CreateRaise(NewException(StringExceptionType, fmt.Sprintf("Invalid id: %s", value)))
+					}
+					return NewId(value)
+				},
+			),
+		},
+	)
+
+	primitiveClassMap.Set("Id", IdType)
+}
@@ -0,0 +1,66 @@
+package builtin
+
+import (
+	"testing"
+)
+
+func TestIdChecksum(t *testing.T) {
+	testCases := []struct {
+		Id15 string
+		Id18 string
+	}{
+		{"001A0000006Vm9r", "001A0000006Vm9rIAC"},
+		{"003000000000001", "003000000000001AAA"},
+		{"a0BxyZ000001AbC", "a0BxyZ000001AbCEBU"},
+	}
+	for _, testCase := range testCases {
+		if actual := To18(testCase.Id15); actual != testCase.Id18 {
+			t.Errorf("%s: expected %s, actual %s", testCase.Id15, testCase.Id18, actual)
+		}
+		if actual := To15(testCase.Id18); actual != testCase.Id15 {
+			t.Errorf("%s: expected %s, actual %s", testCase.Id18, testCase.Id15, actual)
+		}
+		if !IdEquals(testCase.Id15, testCase.Id18) {
+			t.Errorf("%s: expected to equal %s", testCase.Id15, testCase.Id18)
+		}
+	}
+	for _, invalid := range []string{"", "001", "001A0000006Vm9rXXX", "001A0000006Vm9-"} {
+		if ValidId(invalid) {
+			t.Errorf("%s: expected invalid Id", invalid)
+		}
+	}
+}
+
+func TestNewRecordId(t *testing.T) {
+	prev := sObjects
+	sObjects = map[string]Sobject{
+		"Account":    {Name: "Account"},
+		"Invoice__c": {Name: "Invoice__c", Custom: true},
+		"Payment__c": {Name: "Payment__c", Custom: true, KeyPrefix: "a00"},
+	}
+	assignKeyPrefixes(sObjects)
+	defer func() {
+		sObjects = prev
+	}()
+	testCases := []struct {
+		SObjectType string
+		KeyPrefix   string
+	}{
+		{"Account", "001"},
+		{"Payment__c", "a00"},
+		{"Invoice__c", "a01"},
+		{"AsyncApexJob", "707"},
+	}
+	for _, testCase := range testCases {
+		id := newRecordId(testCase.SObjectType)
+		if len(id) != 18 || !ValidId(id) || id[:3] != testCase.KeyPrefix {
+			t.Errorf("%s: expected 18 characters Id with %s, actual %s", testCase.SObjectType, testCase.KeyPrefix, id)
+		}
+		if name, ok := sObjectTypeOfId(id); !ok || name != testCase.SObjectType {
+			t.Errorf("%s: expected sObject of %s, actual %s", testCase.SObjectType, id, name)
+		}
+	}
+	if first, second := newRecordId("Account"), newRecordId("Account"); first >= second {
+		t.Errorf("expected increasing Ids, actual %s, %s", first, second)
+	}
+}
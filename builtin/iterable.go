@@ -0,0 +1,56 @@
+package builtin
+
+import "github.com/tzmfreedom/land/ast"
+
+var IterableType = ast.CreateClass(
+	"Iterable",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var IteratorType = ast.CreateClass(
+	"Iterator",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+func init() {
+	IterableType.Interface = true
+	IterableType.Generics = []*ast.ClassType{T1type}
+	IterableType.InstanceMethods.Set(
+		"iterator",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"iterator",
+				&ast.ClassType{
+					Name:            "Iterator",
+					InstanceMethods: IteratorType.InstanceMethods,
+					StaticMethods:   IteratorType.StaticMethods,
+					Generics:        []*ast.ClassType{T1type},
+				},
+				[]*ast.Parameter{},
+				nil,
+			),
+		},
+	)
+
+	IteratorType.Interface = true
+	IteratorType.Generics = []*ast.ClassType{T1type}
+	IteratorType.InstanceMethods.Set(
+		"hasNext",
+		[]*ast.Method{
+			ast.CreateMethod("hasNext", BooleanType, []*ast.Parameter{}, nil),
+		},
+	)
+	IteratorType.InstanceMethods.Set(
+		"next",
+		[]*ast.Method{
+			ast.CreateMethod("next", T1type, []*ast.Parameter{}, nil),
+		},
+	)
+
+	primitiveClassMap.Set("Iterable", IterableType)
+	primitiveClassMap.Set("Iterator", IteratorType)
+}
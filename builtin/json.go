@@ -55,7 +55,7 @@ func init() {
 					srcMap := map[string]interface{}{}
 					err := json.Unmarshal([]byte(params[0].StringValue()), &srcMap)
 					if err != nil {
-						panic(err)
+						return CreateRaise(NewException(JSONExceptionType, err.Error()))
 					}
 					return deserializeJson(srcMap)
 				},
@@ -0,0 +1,313 @@
+package builtin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// LimitThresholds are the governor limits of a transaction
+type LimitThresholds struct {
+	Queries          int `yaml:"queries"`
+	QueryRows        int `yaml:"query_rows"`
+	SoslQueries      int `yaml:"sosl_queries"`
+	DmlStatements    int `yaml:"dml_statements"`
+	DmlRows          int `yaml:"dml_rows"`
+	CpuTime          int `yaml:"cpu_time"`  // milliseconds
+	HeapSize         int `yaml:"heap_size"` // bytes
+	Callouts         int `yaml:"callouts"`
+	FutureCalls      int `yaml:"future_calls"`
+	EmailInvocations int `yaml:"email_invocations"`
+	QueueableJobs    int `yaml:"queueable_jobs"`
+}
+
+// SyncLimitThresholds are the default limits of synchronous Apex
+var SyncLimitThresholds = &LimitThresholds{
+	Queries:          100,
+	QueryRows:        50000,
+	SoslQueries:      20,
+	DmlStatements:    150,
+	DmlRows:          10000,
+	CpuTime:          10000,
+	HeapSize:         6 * 1024 * 1024,
+	Callouts:         100,
+	FutureCalls:      50,
+	EmailInvocations: 10,
+	QueueableJobs:    50,
+}
+
+// AsyncLimitThresholds are the default limits of asynchronous Apex,
+// such as future methods, queueable and batch jobs
+var AsyncLimitThresholds = &LimitThresholds{
+	Queries:          200,
+	QueryRows:        50000,
+	SoslQueries:      20,
+	DmlStatements:    150,
+	DmlRows:          10000,
+	CpuTime:          60000,
+	HeapSize:         12 * 1024 * 1024,
+	Callouts:         100,
+	FutureCalls:      50,
+	EmailInvocations: 10,
+	QueueableJobs:    1,
+}
+
+// Limits counts the resources consumed in a transaction
+type Limits struct {
+	Thresholds       *LimitThresholds
+	Queries          int
+	QueryRows        int
+	SoslQueries      int
+	DmlStatements    int
+	DmlRows          int
+	Callouts         int
+	FutureCalls      int
+	EmailInvocations int
+	QueueableJobs    int
+	startedAt        time.Time
+	storageTime      time.Duration // storageTime when the transaction started
+}
+
+func NewLimits(thresholds *LimitThresholds) *Limits {
+	return &Limits{
+		Thresholds:  thresholds,
+		startedAt:   time.Now(),
+		storageTime: storageTime,
+	}
+}
+
+// storageTime is the total time spent in the SQL storage, which is not counted as CPU time
+// like the database operations on Salesforce
+var storageTime time.Duration
+
+// measureStorageTime adds the time since start to storageTime, used as defer measureStorageTime(time.Now())
+func measureStorageTime(start time.Time) {
+	storageTime += time.Since(start)
+}
+
+// LimitCounter provides the limits of the running transaction, which is implemented by interpreter
+type LimitCounter interface {
+	Limits() *Limits
+	HeapSize() int
+}
+
+// CpuTime returns the milliseconds elapsed since the transaction started, except the time spent in the storage
+func (l *Limits) CpuTime() int {
+	elapsed := time.Since(l.startedAt) - (storageTime - l.storageTime)
+	return int(elapsed / time.Millisecond)
+}
+
+// AddQuery counts a SOQL query, LimitException is returned if it exceeds the limit
+func (l *Limits) AddQuery() *ast.Object {
+	l.Queries++
+	return limitException(l.Queries, l.Thresholds.Queries, "Too many SOQL queries: %d")
+}
+
+func (l *Limits) AddQueryRows(rows int) *ast.Object {
+	l.QueryRows += rows
+	return limitException(l.QueryRows, l.Thresholds.QueryRows, "Too many query rows: %d")
+}
+
+// AddSoslQuery counts a SOSL query, LimitException is returned if it exceeds the limit
+func (l *Limits) AddSoslQuery() *ast.Object {
+	l.SoslQueries++
+	return limitException(l.SoslQueries, l.Thresholds.SoslQueries, "Too many SOSL queries: %d")
+}
+
+func (l *Limits) AddDml(rows int) *ast.Object {
+	l.DmlStatements++
+	if exception := limitException(l.DmlStatements, l.Thresholds.DmlStatements, "Too many DML statements: %d"); exception != nil {
+		return exception
+	}
+	l.DmlRows += rows
+	return limitException(l.DmlRows, l.Thresholds.DmlRows, "Too many DML rows: %d")
+}
+
+func (l *Limits) AddCallout() *ast.Object {
+	l.Callouts++
+	return limitException(l.Callouts, l.Thresholds.Callouts, "Too many callouts: %d")
+}
+
+func (l *Limits) AddFutureCall() *ast.Object {
+	l.FutureCalls++
+	return limitException(l.FutureCalls, l.Thresholds.FutureCalls, "Too many future calls: %d")
+}
+
+func (l *Limits) AddEmailInvocation() *ast.Object {
+	l.EmailInvocations++
+	return limitException(l.EmailInvocations, l.Thresholds.EmailInvocations, "Too many Email Invocations: %d")
+}
+
+func (l *Limits) AddQueueableJob() *ast.Object {
+	l.QueueableJobs++
+	return limitException(l.QueueableJobs, l.Thresholds.QueueableJobs, "Too many queueable jobs added to the queue: %d")
+}
+
+func (l *Limits) CheckCpuTime() *ast.Object {
+	if l.CpuTime() > l.Thresholds.CpuTime {
+		return NewException(LimitExceptionType, "Apex CPU time limit exceeded")
+	}
+	return nil
+}
+
+func (l *Limits) CheckHeapSize(size int) *ast.Object {
+	return limitException(size, l.Thresholds.HeapSize, "Apex heap size too large: %d")
+}
+
+func limitException(value, limit int, format string) *ast.Object {
+	if value > limit {
+		return NewException(LimitExceptionType, fmt.Sprintf(format, value))
+	}
+	return nil
+}
+
+// IsLimitException reports whether the exception can not be caught
+func IsLimitException(exception *ast.Object) bool {
+	return exception.ClassType == LimitExceptionType
+}
+
+// HeapSizeOf approximates the bytes that the object occupies in Apex heap,
+// the objects in visited are not counted twice
+func HeapSizeOf(obj *ast.Object, visited map[*ast.Object]bool) int {
+	if obj == nil || obj == Null || visited[obj] {
+		return 0
+	}
+	visited[obj] = true
+	switch obj.ClassType {
+	case IntegerType:
+		return 4
+	case LongType, DoubleType, DateType, DatetimeType:
+		return 8
+	case BooleanType:
+		return 1
+	case StringType:
+		return len(obj.StringValue())
+	}
+	size := 8
+	if records, ok := obj.Extra["records"].([]*ast.Object); ok {
+		for _, record := range records {
+			size += HeapSizeOf(record, visited)
+		}
+	}
+	switch values := obj.Extra["values"].(type) {
+	case map[string]*ast.Object:
+		for key, value := range values {
+			size += len(key) + HeapSizeOf(value, visited)
+		}
+	case map[string]struct{}:
+		for key := range values {
+			size += len(key)
+		}
+	}
+	if obj.InstanceFields != nil {
+		for _, value := range obj.InstanceFields.Data {
+			size += HeapSizeOf(value, visited)
+		}
+	}
+	return size
+}
+
+func init() {
+	staticMethods := ast.NewMethodMap()
+	limitsType := ast.CreateClass(
+		"Limits",
+		[]*ast.Method{},
+		ast.NewMethodMap(),
+		staticMethods,
+	)
+
+	for _, limit := range []struct {
+		name      string
+		used      func(LimitCounter) int
+		threshold func(*LimitThresholds) int
+	}{
+		{
+			"Queries",
+			func(c LimitCounter) int { return c.Limits().Queries },
+			func(t *LimitThresholds) int { return t.Queries },
+		},
+		{
+			"QueryRows",
+			func(c LimitCounter) int { return c.Limits().QueryRows },
+			func(t *LimitThresholds) int { return t.QueryRows },
+		},
+		{
+			"SoslQueries",
+			func(c LimitCounter) int { return c.Limits().SoslQueries },
+			func(t *LimitThresholds) int { return t.SoslQueries },
+		},
+		{
+			"DmlStatements",
+			func(c LimitCounter) int { return c.Limits().DmlStatements },
+			func(t *LimitThresholds) int { return t.DmlStatements },
+		},
+		{
+			"DmlRows",
+			func(c LimitCounter) int { return c.Limits().DmlRows },
+			func(t *LimitThresholds) int { return t.DmlRows },
+		},
+		{
+			"CpuTime",
+			func(c LimitCounter) int { return c.Limits().CpuTime() },
+			func(t *LimitThresholds) int { return t.CpuTime },
+		},
+		{
+			"HeapSize",
+			func(c LimitCounter) int { return c.HeapSize() },
+			func(t *LimitThresholds) int { return t.HeapSize },
+		},
+		{
+			"Callouts",
+			func(c LimitCounter) int { return c.Limits().Callouts },
+			func(t *LimitThresholds) int { return t.Callouts },
+		},
+		{
+			"FutureCalls",
+			func(c LimitCounter) int { return c.Limits().FutureCalls },
+			func(t *LimitThresholds) int { return t.FutureCalls },
+		},
+		{
+			"EmailInvocations",
+			func(c LimitCounter) int { return c.Limits().EmailInvocations },
+			func(t *LimitThresholds) int { return t.EmailInvocations },
+		},
+		{
+			"QueueableJobs",
+			func(c LimitCounter) int { return c.Limits().QueueableJobs },
+			func(t *LimitThresholds) int { return t.QueueableJobs },
+		},
+	} {
+		used := limit.used
+		threshold := limit.threshold
+		staticMethods.Set(
+			"get"+limit.name,
+			[]*ast.Method{
+				ast.CreateMethod(
+					"get"+limit.name,
+					IntegerType,
+					[]*ast.Parameter{},
+					func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+						return NewInteger(used(extra["interpreter"].(LimitCounter)))
+					},
+				),
+			},
+		)
+		staticMethods.Set(
+			"getLimit"+limit.name,
+			[]*ast.Method{
+				ast.CreateMethod(
+					"getLimit"+limit.name,
+					IntegerType,
+					[]*ast.Parameter{},
+					func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+						counter := extra["interpreter"].(LimitCounter)
+						return NewInteger(threshold(counter.Limits().Thresholds))
+					},
+				),
+			},
+		)
+	}
+
+	primitiveClassMap.Set("Limits", limitsType)
+}
@@ -0,0 +1,43 @@
+package builtin
+
+import (
+	"io/ioutil"
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+const DefaultLimitsConfigName = "limits.yml"
+
+// LimitsConfig overrides the thresholds of the governor limits, the omitted limits keep the defaults
+//
+//	sync:
+//	  queries: 200
+//	  cpu_time: 20000
+//	async:
+//	  queueable_jobs: 2
+type LimitsConfig struct {
+	Sync  LimitThresholds `yaml:"sync"`
+	Async LimitThresholds `yaml:"async"`
+}
+
+// the thresholds of Salesforce, which the config file overrides
+var defaultSyncLimitThresholds, defaultAsyncLimitThresholds = *SyncLimitThresholds, *AsyncLimitThresholds
+
+// LoadLimitsConfig loads the config file into SyncLimitThresholds and AsyncLimitThresholds, which is optional
+func LoadLimitsConfig(src string) error {
+	config := &LimitsConfig{
+		Sync:  defaultSyncLimitThresholds,
+		Async: defaultAsyncLimitThresholds,
+	}
+	body, err := ioutil.ReadFile(src)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := yaml.Unmarshal(body, config); err != nil {
+		return err
+	}
+	*SyncLimitThresholds = config.Sync
+	*AsyncLimitThresholds = config.Async
+	return nil
+}
@@ -0,0 +1,34 @@
+package builtin
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCpuTimeExcludesStorageTime(t *testing.T) {
+	limits := NewLimits(SyncLimitThresholds)
+	limits.startedAt = time.Now().Add(-3 * time.Second)
+	measureStorageTime(time.Now().Add(-2 * time.Second))
+	if cpuTime := limits.CpuTime(); cpuTime < 900 || cpuTime > 1500 {
+		t.Errorf("expected about 1000 milliseconds except the storage time, actual %d", cpuTime)
+	}
+}
+
+func TestLoadLimitsConfig(t *testing.T) {
+	defer LoadLimitsConfig("")
+	if err := LoadLimitsConfig("../fixtures/limits/limits.yml"); err != nil {
+		t.Fatal(err)
+	}
+	if SyncLimitThresholds.Queries != 50 || SyncLimitThresholds.CpuTime != 20000 {
+		t.Errorf("expected the limits of the config, actual %d queries and %d ms", SyncLimitThresholds.Queries, SyncLimitThresholds.CpuTime)
+	}
+	if SyncLimitThresholds.DmlStatements != 150 || AsyncLimitThresholds.Queries != 200 {
+		t.Errorf("expected the default limits for the omitted limits")
+	}
+	if err := LoadLimitsConfig("not_found.yml"); err != nil {
+		t.Fatal(err)
+	}
+	if SyncLimitThresholds.Queries != 100 {
+		t.Errorf("expected the default limits without the config, actual %d queries", SyncLimitThresholds.Queries)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/tzmfreedom/land/ast"
 )
 
-var ListType = &ast.ClassType{Name: "List"}
+// InstanceMethods is allocated here so that list types created
+// before createListType, such as values() of builtin enums, share the methods
+var ListType = &ast.ClassType{Name: "List", InstanceMethods: ast.NewMethodMap()}
 
 var ListTypeParameter = &ast.Parameter{
 	Type: ListType,
@@ -71,7 +73,7 @@ var t2TypeRef = &ast.TypeRef{
 }
 
 func createListType() {
-	instanceMethods := ast.NewMethodMap()
+	instanceMethods := ListType.InstanceMethods
 	instanceMethods.Set(
 		"add",
 		[]*ast.Method{
@@ -176,7 +178,6 @@ func createListType() {
 	}
 	ListType.InstanceFields = ast.NewFieldMap()
 	ListType.StaticFields = ast.NewFieldMap()
-	ListType.InstanceMethods = instanceMethods
 	ListType.StaticMethods = ast.NewMethodMap()
 }
 
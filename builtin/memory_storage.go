@@ -0,0 +1,338 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// memoryRecord is the field values of the saved record, whose keys are lower case field names.
+// It is never modified after saved, so that the snapshots can share it.
+type memoryRecord map[string]*ast.Object
+
+func (r memoryRecord) id() string {
+	if id, ok := r["id"]; ok && id != Null {
+		return soqlString(id)
+	}
+	return ""
+}
+
+type memoryTable struct {
+	sObjectType string
+	records     []memoryRecord // in the order of insertion
+}
+
+func (t *memoryTable) copy() *memoryTable {
+	records := make([]memoryRecord, len(t.records))
+	copy(records, t.records)
+	return &memoryTable{sObjectType: t.sObjectType, records: records}
+}
+
+func (t *memoryTable) find(id string) memoryRecord {
+	for _, record := range t.records {
+		if IdEquals(record.id(), id) {
+			return record
+		}
+	}
+	return nil
+}
+
+func (t *memoryTable) update(id string, values memoryRecord) {
+	for i, record := range t.records {
+		if !IdEquals(record.id(), id) {
+			continue
+		}
+		updated := memoryRecord{}
+		for name, value := range record {
+			updated[name] = value
+		}
+		for name, value := range values {
+			updated[name] = value
+		}
+		t.records[i] = updated
+	}
+}
+
+func (t *memoryTable) delete(id string) {
+	records := []memoryRecord{}
+	for _, record := range t.records {
+		if !IdEquals(record.id(), id) {
+			records = append(records, record)
+		}
+	}
+	t.records = records
+}
+
+type memorySnapshot struct {
+	name   string // the savepoint name, or empty for the transaction
+	tables map[string]*memoryTable
+}
+
+// memoryStorage keeps the records in memory and evaluates the SOQL on them without SQL.
+// The transaction and the savepoints are the snapshots of the tables, which share the records.
+type memoryStorage struct {
+	tables    map[string]*memoryTable // key: lower case sObject name
+	snapshots []memorySnapshot
+}
+
+func newMemoryStorage() *memoryStorage {
+	return &memoryStorage{tables: map[string]*memoryTable{}}
+}
+
+// table returns the table of the sObject, which is created on the first use
+func (s *memoryStorage) table(sObjectType string) *memoryTable {
+	key := strings.ToLower(sObjectType)
+	if table, ok := s.tables[key]; ok {
+		return table
+	}
+	table := &memoryTable{sObjectType: sObjectType}
+	s.tables[key] = table
+	return table
+}
+
+func (s *memoryStorage) snapshot() map[string]*memoryTable {
+	tables := make(map[string]*memoryTable, len(s.tables))
+	for key, table := range s.tables {
+		tables[key] = table.copy()
+	}
+	return tables
+}
+
+func (s *memoryStorage) Query(n *ast.Soql, interpreter ast.Visitor) ([]*ast.Object, error) {
+	evaluator := &soqlEvaluator{
+		storage:     s,
+		interpreter: interpreter,
+		values:      map[ast.Node]*ast.Object{},
+	}
+	return evaluator.Evaluate(n), nil
+}
+
+func (s *memoryStorage) Execute(dmlType string, sObjectType string, records []*ast.Object, upsertKey string) (*ast.Object, error) {
+	table := s.table(sObjectType)
+	saveResults := make([]*ast.Object, len(records))
+	for i, record := range records {
+		switch dmlType {
+		case "insert", "undelete":
+			// deleted records are inserted again with their Id on undelete
+			if dmlType == "insert" {
+				record.InstanceFields.Set("Id", NewId(newRecordId(sObjectType)))
+			}
+			table.records = append(table.records, newMemoryRecord(record))
+		case "update", "delete":
+			id, err := recordId(record)
+			if err != nil {
+				return nil, err
+			}
+			if dmlType == "update" {
+				table.update(id, newMemoryRecord(record))
+			} else {
+				table.delete(id)
+			}
+		}
+		saveResults[i] = newSaveResult(dmlType, record)
+	}
+	return CreateListObject(nil, saveResults), nil
+}
+
+// newMemoryRecord copies the field values of the record except the related records,
+// the null fields are copied only if they are set, which clear the values on update
+func newMemoryRecord(record *ast.Object) memoryRecord {
+	values := memoryRecord{}
+	for name, field := range record.InstanceFields.All() {
+		if !isSavedField(record, name, field) {
+			continue
+		}
+		if field == Null {
+			values[strings.ToLower(name)] = Null
+			continue
+		}
+		if _, ok := field.Extra["value"]; !ok {
+			continue
+		}
+		extra := make(map[string]interface{}, len(field.Extra))
+		for key, value := range field.Extra {
+			extra[key] = value
+		}
+		values[strings.ToLower(name)] = &ast.Object{
+			ClassType:      field.ClassType,
+			InstanceFields: field.InstanceFields,
+			Extra:          extra,
+		}
+	}
+	return values
+}
+
+func recordId(record *ast.Object) (string, error) {
+	id, ok := record.InstanceFields.Get("Id")
+	if !ok || id == Null {
+		return "", errors.New("id does not exist")
+	}
+	return id.StringValue(), nil
+}
+
+// FindRecords returns the records whose field has one of the values
+func (s *memoryStorage) FindRecords(sObjectType string, field string, values []string) ([]*ast.Object, error) {
+	found := map[string]bool{}
+	for _, value := range values {
+		found[value] = true
+	}
+	records := []*ast.Object{}
+	for _, record := range s.table(sObjectType).records {
+		value, ok := record[strings.ToLower(field)]
+		if !ok || value == Null || !found[soqlString(value)] {
+			continue
+		}
+		records = append(records, s.newRecordObject(sObjectType, record))
+	}
+	return records, nil
+}
+
+// newRecordObject creates the SObject of all fields, the fields without value are null
+func (s *memoryStorage) newRecordObject(sObjectType string, record memoryRecord) *ast.Object {
+	obj := ast.CreateObject(sObjectClassType(sObjectType))
+	if sobject, ok := findSObject(sObjectType); ok {
+		for _, field := range sobject.Fields {
+			obj.InstanceFields.Set(field.Name, Null)
+		}
+	}
+	for name, value := range record {
+		obj.InstanceFields.Set(name, value)
+	}
+	return obj
+}
+
+func (s *memoryStorage) CreateTable(name string, sobject Sobject) error {
+	s.table(name)
+	return nil
+}
+
+func (s *memoryStorage) Insert(sObjectType string, values map[string]interface{}) error {
+	record, err := newMemoryRecordOf(sObjectType, values)
+	if err != nil {
+		return err
+	}
+	table := s.table(sObjectType)
+	table.records = append(table.records, record)
+	return nil
+}
+
+func (s *memoryStorage) Update(sObjectType string, id string, values map[string]interface{}) error {
+	record, err := newMemoryRecordOf(sObjectType, values)
+	if err != nil {
+		return err
+	}
+	s.table(sObjectType).update(id, record)
+	return nil
+}
+
+func (s *memoryStorage) Delete(sObjectType string, id string) error {
+	s.table(sObjectType).delete(id)
+	return nil
+}
+
+// newMemoryRecordOf converts the values of Go to the field values
+func newMemoryRecordOf(sObjectType string, values map[string]interface{}) (memoryRecord, error) {
+	record := memoryRecord{}
+	for name, value := range values {
+		var field *ast.Object
+		switch v := value.(type) {
+		case nil:
+			field = Null
+		case string:
+			field = newFieldValue(sObjectType, name, v)
+		case int:
+			field = NewInteger(v)
+		case float64:
+			field = NewDouble(v)
+		case bool:
+			field = NewBoolean(v)
+		default:
+			return nil, fmt.Errorf("unsupported value %v of %s", value, name)
+		}
+		record[strings.ToLower(name)] = field
+	}
+	return record, nil
+}
+
+func (s *memoryStorage) ExecuteRaw(query string, args ...interface{}) error {
+	return errors.New("SQL is not supported by the in-memory storage")
+}
+
+func (s *memoryStorage) Quote(identifier string) string {
+	return identifier
+}
+
+func (s *memoryStorage) Begin() error {
+	if len(s.snapshots) != 0 {
+		return errors.New("cannot start a transaction within a transaction")
+	}
+	s.snapshots = []memorySnapshot{{tables: s.snapshot()}}
+	return nil
+}
+
+func (s *memoryStorage) Commit() error {
+	if len(s.snapshots) == 0 {
+		return errors.New("cannot commit - no transaction is active")
+	}
+	s.snapshots = nil
+	return nil
+}
+
+func (s *memoryStorage) Rollback() error {
+	if len(s.snapshots) == 0 {
+		return errors.New("cannot rollback - no transaction is active")
+	}
+	s.tables = s.snapshots[0].tables
+	s.snapshots = nil
+	return nil
+}
+
+// Isolate replaces the tables with the empty ones, the tables are restored on Rollback
+func (s *memoryStorage) Isolate() error {
+	if len(s.snapshots) == 0 {
+		return errors.New("cannot isolate the records - no transaction is active")
+	}
+	s.tables = map[string]*memoryTable{}
+	return nil
+}
+
+func (s *memoryStorage) Savepoint(name string) error {
+	s.snapshots = append(s.snapshots, memorySnapshot{name: name, tables: s.snapshot()})
+	return nil
+}
+
+// RollbackToSavepoint restores the tables of the savepoint, the savepoint itself remains as SQL
+func (s *memoryStorage) RollbackToSavepoint(name string) error {
+	i, err := s.findSavepoint(name)
+	if err != nil {
+		return err
+	}
+	s.snapshots = s.snapshots[:i+1]
+	s.tables = s.snapshots[i].tables
+	s.snapshots[i].tables = s.snapshot()
+	return nil
+}
+
+func (s *memoryStorage) ReleaseSavepoint(name string) error {
+	i, err := s.findSavepoint(name)
+	if err != nil {
+		return err
+	}
+	s.snapshots = s.snapshots[:i]
+	return nil
+}
+
+func (s *memoryStorage) findSavepoint(name string) (int, error) {
+	for i := len(s.snapshots) - 1; i >= 0; i-- {
+		if s.snapshots[i].name != "" && strings.EqualFold(s.snapshots[i].name, name) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("no such savepoint: %s", name)
+}
+
+func (s *memoryStorage) Close() error {
+	return nil
+}
@@ -76,6 +76,9 @@ func init() {
 					CreateListTypeParameter(singleEmailMessageType),
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if exception := extra["interpreter"].(LimitCounter).Limits().AddEmailInvocation(); exception != nil {
+						return CreateRaise(exception)
+					}
 					// TODO: implment
 					obj := ast.CreateObject(singleEmailMessageType)
 					obj.InstanceFields.Set("errors", NewString("hoge"))
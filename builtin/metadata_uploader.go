@@ -36,15 +36,32 @@ func createMetadata(username, password, endpoint string, standardObjects []strin
 					Type:             string(*f.Type_),
 					Custom:           f.Custom,
 					ReferenceTo:      f.ReferenceTo,
+					Required:         f.Createable && !f.Nillable && !f.DefaultedOnCreate,
+				},
+			)
+		}
+		childRelationships := []ChildRelationship{}
+		for _, c := range r.ChildRelationships {
+			if c.RelationshipName == "" {
+				continue
+			}
+			childRelationships = append(
+				childRelationships,
+				ChildRelationship{
+					RelationshipName: c.RelationshipName,
+					ChildSObject:     c.ChildSObject,
+					Field:            c.Field,
 				},
 			)
 		}
 		sobjects[sobj.Name] = Sobject{
-			Name:          sobj.Name,
-			Custom:        sobj.Custom,
-			CustomSetting: sobj.CustomSetting,
-			Label:         sobj.Label,
-			Fields:        fields,
+			Name:               sobj.Name,
+			Custom:             sobj.Custom,
+			CustomSetting:      sobj.CustomSetting,
+			Label:              sobj.Label,
+			KeyPrefix:          sobj.KeyPrefix,
+			Fields:             fields,
+			ChildRelationships: childRelationships,
 		}
 	}
 	return sobjects, nil
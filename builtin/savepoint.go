@@ -0,0 +1,58 @@
+package builtin
+
+import (
+	"github.com/tzmfreedom/land/ast"
+)
+
+// SavepointExecutor sets the savepoints of the running transaction and rolls back to them, which is implemented by interpreter
+type SavepointExecutor interface {
+	SetSavepoint() (*ast.Object, error)
+	RollbackToSavepoint(savepoint *ast.Object) error
+}
+
+var SavepointType = ast.CreateClass(
+	"Savepoint",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+// NewSavepoint creates the Savepoint returned by Database.setSavepoint, name is the savepoint of the storage
+func NewSavepoint(name string) *ast.Object {
+	obj := ast.CreateObject(SavepointType)
+	obj.Extra["name"] = name
+	return obj
+}
+
+// SavepointName returns the name of the savepoint in the storage
+func SavepointName(savepoint *ast.Object) string {
+	return savepoint.Extra["name"].(string)
+}
+
+func setSavepoint(extra map[string]interface{}) interface{} {
+	savepoint, err := extra["interpreter"].(SavepointExecutor).SetSavepoint()
+	if err != nil {
+		if raise, ok := err.(*RaiseError); ok {
+			return CreateRaise(raise.Exception)
+		}
+		panic(err)
+	}
+	return savepoint
+}
+
+func rollbackToSavepoint(savepoint *ast.Object, extra map[string]interface{}) interface{} {
+	if savepoint == Null {
+		return CreateRaise(NewException(NullPointerExceptionType, "Argument cannot be null"))
+	}
+	if err := extra["interpreter"].(SavepointExecutor).RollbackToSavepoint(savepoint); err != nil {
+		if raise, ok := err.(*RaiseError); ok {
+			return CreateRaise(raise.Exception)
+		}
+		panic(err)
+	}
+	return nil
+}
+
+func init() {
+	primitiveClassMap.Set("Savepoint", SavepointType)
+}
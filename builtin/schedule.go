@@ -0,0 +1,228 @@
+package builtin
+
+import (
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+const (
+	CronTriggerStateWaiting  = "WAITING"
+	CronTriggerStateComplete = "COMPLETE"
+	CronTriggerStateDeleted  = "DELETED"
+)
+
+// cronJobTypeScheduledApex is the JobType of CronJobDetail for Schedulable
+const cronJobTypeScheduledApex = "7"
+
+// MaxScheduledJobs is the number of the Apex scheduled jobs at a time
+const MaxScheduledJobs = 100
+
+// CronTimeLayout is the format of the fire times saved in CronTrigger
+const CronTimeLayout = "2006-01-02 15:04:05"
+
+// Scheduler registers the Schedulable of System.schedule and aborts the jobs, which is implemented by interpreter
+type Scheduler interface {
+	Schedule(name string, cron *CronExpression, schedulable *ast.Object) (string, error)
+	AbortJob(jobId string) error
+}
+
+// CronTrigger is the record of the job scheduled by System.schedule, which is saved with its CronJobDetail
+type CronTrigger struct {
+	Id               string
+	CronJobDetailId  string
+	Name             string // Name of CronJobDetail, which is unique in the scheduled jobs
+	CronExpression   string
+	State            string
+	StartTime        time.Time
+	NextFireTime     time.Time // zero if the job never fires again
+	PreviousFireTime time.Time
+	TimesTriggered   int
+}
+
+// the fire times are saved as the text, as the datetime fields are not typed
+var cronTriggerSObject = Sobject{
+	Name:      "CronTrigger",
+	Label:     "Scheduled Jobs",
+	KeyPrefix: "08e",
+	Fields: []SobjectField{
+		{Name: "Id", Type: "id"},
+		{Name: "CronJobDetailId", Type: "reference", RelationshipName: "CronJobDetail", ReferenceTo: []string{"CronJobDetail"}},
+		{Name: "CronExpression", Type: "string"},
+		{Name: "State", Type: "picklist"},
+		{Name: "StartTime", Type: "string"},
+		{Name: "NextFireTime", Type: "string"},
+		{Name: "PreviousFireTime", Type: "string"},
+		{Name: "TimesTriggered", Type: "int"},
+	},
+}
+
+var cronJobDetailSObject = Sobject{
+	Name:      "CronJobDetail",
+	Label:     "Job",
+	KeyPrefix: "08a",
+	Fields: []SobjectField{
+		{Name: "Id", Type: "id"},
+		{Name: "Name", Type: "string"},
+		{Name: "JobType", Type: "picklist"},
+	},
+}
+
+func formatCronTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(CronTimeLayout)
+}
+
+// Insert saves the scheduled job and its CronJobDetail
+func (t *CronTrigger) Insert() error {
+	err := insertStandardRecord(cronJobDetailSObject, []interface{}{
+		t.CronJobDetailId,
+		t.Name,
+		cronJobTypeScheduledApex,
+	})
+	if err != nil {
+		return err
+	}
+	return insertStandardRecord(cronTriggerSObject, []interface{}{
+		t.Id,
+		t.CronJobDetailId,
+		t.CronExpression,
+		t.State,
+		formatCronTime(t.StartTime),
+		formatCronTime(t.NextFireTime),
+		formatCronTime(t.PreviousFireTime),
+		t.TimesTriggered,
+	})
+}
+
+// Update saves the state and the fire times of the job
+func (t *CronTrigger) Update() error {
+	return DatabaseDriver.Update(cronTriggerSObject.Name, t.Id, map[string]interface{}{
+		"State":            t.State,
+		"NextFireTime":     formatCronTime(t.NextFireTime),
+		"PreviousFireTime": formatCronTime(t.PreviousFireTime),
+		"TimesTriggered":   t.TimesTriggered,
+	})
+}
+
+// Delete removes the aborted job and its CronJobDetail
+func (t *CronTrigger) Delete() error {
+	if err := DatabaseDriver.Delete(cronTriggerSObject.Name, t.Id); err != nil {
+		return err
+	}
+	return DatabaseDriver.Delete(cronJobDetailSObject.Name, t.CronJobDetailId)
+}
+
+var SchedulableType = ast.CreateClass(
+	"Schedulable",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var schedulableContextType = ast.CreateClass(
+	"SchedulableContext",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+// NewSchedulableContext creates the context passed to Schedulable#execute
+func NewSchedulableContext(triggerId string) *ast.Object {
+	obj := ast.CreateObject(schedulableContextType)
+	obj.Extra["triggerId"] = triggerId
+	return obj
+}
+
+// setScheduleMethods sets the methods of System for the scheduled jobs
+func setScheduleMethods(staticMethods *ast.MethodMap) {
+	staticMethods.Set(
+		"schedule",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"schedule",
+				StringType,
+				[]*ast.Parameter{
+					stringTypeParameter,
+					stringTypeParameter,
+					{Type: SchedulableType, Name: "_"},
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					for _, param := range params {
+						if param == Null {
+							return CreateRaise(NewException(NullPointerExceptionType, "Argument cannot be null"))
+						}
+					}
+					cron, err := ParseCronExpression(params[1].StringValue())
+					if err != nil {
+						return CreateRaise(NewException(StringExceptionType, err.Error()))
+					}
+					triggerId, err := extra["interpreter"].(Scheduler).Schedule(params[0].StringValue(), cron, params[2])
+					if err != nil {
+						if raise, ok := err.(*RaiseError); ok {
+							return CreateRaise(raise.Exception)
+						}
+						panic(err)
+					}
+					return NewString(triggerId)
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"abortJob",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"abortJob",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return CreateRaise(NewException(NullPointerExceptionType, "Argument cannot be null"))
+					}
+					if err := extra["interpreter"].(Scheduler).AbortJob(params[0].StringValue()); err != nil {
+						if raise, ok := err.(*RaiseError); ok {
+							return CreateRaise(raise.Exception)
+						}
+						panic(err)
+					}
+					return nil
+				},
+			),
+		},
+	)
+}
+
+func init() {
+	SchedulableType.Interface = true
+	SchedulableType.InstanceMethods.Set(
+		"execute",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"execute",
+				nil,
+				[]*ast.Parameter{{Type: schedulableContextType, Name: "_"}},
+				nil,
+			),
+		},
+	)
+
+	schedulableContextType.InstanceMethods.Set(
+		"getTriggerId",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getTriggerId",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["triggerId"].(string))
+				},
+			),
+		},
+	)
+
+	primitiveClassMap.Set("Schedulable", SchedulableType)
+	primitiveClassMap.Set("SchedulableContext", schedulableContextType)
+}
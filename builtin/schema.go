@@ -5,6 +5,13 @@ import "github.com/tzmfreedom/land/ast"
 var schemaSObjectType *ast.ClassType
 var describeSObjectResultType *ast.ClassType
 
+// NewSObjectTypeObject creates Schema.SObjectType of the SObject, such as Account.sObjectType
+func NewSObjectTypeObject(name string) *ast.Object {
+	obj := ast.CreateObject(schemaSObjectType)
+	obj.Extra["type"] = name
+	return obj
+}
+
 func init() {
 	schema := ast.CreateClass(
 		"Schema",
@@ -23,9 +30,7 @@ func init() {
 							newObj := ast.CreateObject(mapType)
 							values := map[string]*ast.Object{}
 							for name, _ := range sObjects {
-								valueObj := ast.CreateObject(schemaSObjectType)
-								valueObj.Extra["type"] = name
-								values[name] = valueObj
+								values[name] = NewSObjectTypeObject(name)
 							}
 							newObj.Extra["values"] = values
 							return newObj
@@ -80,6 +85,9 @@ func init() {
 			},
 		},
 	)
+	schemaSObjectType.ToString = func(o *ast.Object) string {
+		return o.Extra["type"].(string)
+	}
 	classMap.Set("SObjectType", schemaSObjectType)
 
 	describeSObjectResultType = ast.CreateClass(
@@ -101,4 +109,23 @@ func init() {
 	classMap.Set("SObjectTypeFields", sObjectTypeFields)
 
 	nameSpaceStore.Set("Schema", classMap)
+
+	// Id.getSObjectType is set after Schema.SObjectType is created
+	IdType.InstanceMethods.Set(
+		"getSObjectType",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getSObjectType",
+				schemaSObjectType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					name, ok := sObjectTypeOfId(this.StringValue())
+					if !ok {
+						return Null
+					}
+					return NewSObjectTypeObject(name)
+				},
+			),
+		},
+	)
 }
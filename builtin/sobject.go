@@ -14,7 +14,18 @@ type Sobject struct {
 	Custom        bool
 	CustomSetting bool
 	Label         string
+	KeyPrefix     string // the first 3 characters of the record Id
 	Fields        []SobjectField
+	// ChildRelationships are the relationships from the child sObjects, such as Contacts of Account
+	ChildRelationships []ChildRelationship
+}
+
+// ChildRelationship is the relationship of the child records referring to the parent by the field,
+// which is queried by the subquery such as SELECT Id, (SELECT Id FROM Contacts) FROM Account
+type ChildRelationship struct {
+	RelationshipName string
+	ChildSObject     string
+	Field            string
 }
 
 type SobjectField struct {
@@ -24,6 +35,8 @@ type SobjectField struct {
 	RelationshipName string
 	Custom           bool
 	ReferenceTo      []string
+	// Required is whether the value is required to save the record, which is not nillable nor defaulted on create
+	Required bool
 }
 
 var soapClient *soapforce.Client
@@ -42,21 +55,22 @@ func NewSoapClient(username, password, endpoint string) *soapforce.Client {
 }
 
 var typeMapper = map[string]*ast.ClassType{
-	"string":        StringType,
-	"picklist":      StringType,
-	"multipicklist": StringType,
-	"combobox":      StringType,
-	"reference":     StringType,
-	"boolean":       BooleanType,
-	"currency":      DoubleType,
-	"textarea":      StringType,
-	"int":           DoubleType,
-	"double":        DoubleType,
-	"percent":       DoubleType,
-	"id":            StringType,
-	//"date":                       dateType,
-	//"datetime":                   dateType,
-	//"time":                       TimeType,
+	"string":                     StringType,
+	"picklist":                   StringType,
+	"multipicklist":              StringType,
+	"combobox":                   StringType,
+	"reference":                  IdType,
+	"boolean":                    BooleanType,
+	"currency":                   DoubleType,
+	"textarea":                   StringType,
+	"int":                        IntegerType,
+	"double":                     DoubleType,
+	"percent":                    DoubleType,
+	"id":                         IdType,
+	"date":                       DateType,
+	"datetime":                   DatetimeType,
+	"time":                       timeType,
+	"phone":                      StringType,
 	"url":                        StringType,
 	"email":                      StringType,
 	"encryptedstring":            StringType,
@@ -73,6 +87,13 @@ type Loader interface {
 
 var sObjects map[string]Sobject
 
+// standardSObjects are provided by land itself, they are available even if the metafile does not declare them
+var standardSObjects = map[string]Sobject{
+	asyncApexJobSObject.Name:  asyncApexJobSObject,
+	cronTriggerSObject.Name:   cronTriggerSObject,
+	cronJobDetailSObject.Name: cronJobDetailSObject,
+}
+
 func LoadSObjectClass(src string) {
 	loader := NewMetaFileLoader(src)
 	// TODO: sObject declaration
@@ -81,29 +102,120 @@ func LoadSObjectClass(src string) {
 	if err != nil {
 		panic(err)
 	}
+	for name, sobj := range standardSObjects {
+		if _, ok := sObjects[name]; !ok {
+			sObjects[name] = sobj
+		}
+	}
+	assignKeyPrefixes(sObjects)
+	for name, sobj := range sObjects {
+		primitiveClassMap.Set(name, newSObjectClass(sobj))
+	}
 	for name, sobj := range sObjects {
-		fields := ast.NewFieldMap()
-		for _, f := range sobj.Fields {
-			fields.Set(f.Name, &ast.Field{
-				Type:      typeMapper[f.Type],
-				Name:      f.Name,
-				Modifiers: []*ast.Modifier{ast.PublicModifier()},
-			})
+		classType, _ := primitiveClassMap.Get(name)
+		setRelationshipFields(classType, sobj)
+	}
+}
+
+// setRelationshipFields adds the fields of the parent records such as Contact.Account
+// and the lists of the child records such as Account.Contacts, after the classes of all sObjects are created
+func setRelationshipFields(classType *ast.ClassType, sobj Sobject) {
+	for _, f := range sobj.Fields {
+		if f.RelationshipName == "" || len(f.ReferenceTo) == 0 {
+			continue
 		}
-		primitiveClassMap.Set(name, &ast.ClassType{
-			Name:            sobj.Name,
-			SuperClass:      SObjectType,
-			Constructors:    []*ast.Method{},
-			InstanceFields:  fields,
-			StaticFields:    ast.NewFieldMap(),
-			InstanceMethods: ast.NewMethodMap(),
-			StaticMethods:   ast.NewMethodMap(),
-			ToString:        SObjectType.ToString,
+		// TODO: polymorphic relation
+		parentType, ok := primitiveClassMap.Get(f.ReferenceTo[0])
+		if !ok {
+			continue
+		}
+		classType.InstanceFields.Set(f.RelationshipName, &ast.Field{
+			Type:      parentType,
+			Name:      f.RelationshipName,
+			Modifiers: []*ast.Modifier{ast.PublicModifier()},
+		})
+	}
+	for _, r := range sobj.ChildRelationships {
+		if r.RelationshipName == "" {
+			continue
+		}
+		childType, ok := primitiveClassMap.Get(r.ChildSObject)
+		if !ok {
+			continue
+		}
+		classType.InstanceFields.Set(r.RelationshipName, &ast.Field{
+			Type:      CreateListType(childType),
+			Name:      r.RelationshipName,
+			Modifiers: []*ast.Modifier{ast.PublicModifier()},
 		})
 	}
 }
 
+func newSObjectClass(sobj Sobject) *ast.ClassType {
+	fields := ast.NewFieldMap()
+	for _, f := range sobj.Fields {
+		fields.Set(f.Name, &ast.Field{
+			Type:      typeMapper[f.Type],
+			Name:      f.Name,
+			Modifiers: []*ast.Modifier{ast.PublicModifier()},
+		})
+	}
+	// the value of sObjectType is set by interpreter as Schema.SObjectType has no literal
+	staticFields := ast.NewFieldMap()
+	staticFields.Set("SObjectType", &ast.Field{
+		Type:      schemaSObjectType,
+		Name:      "SObjectType",
+		Modifiers: []*ast.Modifier{ast.PublicModifier(), {Name: "static"}},
+	})
+	return &ast.ClassType{
+		Name:            sobj.Name,
+		SuperClass:      SObjectType,
+		Constructors:    []*ast.Method{},
+		InstanceFields:  fields,
+		StaticFields:    staticFields,
+		InstanceMethods: ast.NewMethodMap(),
+		StaticMethods:   ast.NewMethodMap(),
+		ToString:        SObjectType.ToString,
+	}
+}
+
+// SetField sets the value of the field to the record. The fields of SObject set by it are saved by DML
+// even if they are null, while the other null fields are not saved, such as the fields not queried.
+func SetField(record *ast.Object, name string, value *ast.Object) {
+	record.InstanceFields.Set(name, value)
+	if record.ClassType.SuperClass != SObjectType {
+		return
+	}
+	assigned, ok := record.Extra["assignedFields"].(map[string]bool)
+	if !ok {
+		assigned = map[string]bool{}
+		record.Extra["assignedFields"] = assigned
+	}
+	assigned[strings.ToLower(name)] = true
+}
+
+// isSavedField reports whether the field value is saved by DML, the null field is saved if it is set by SetField
+func isSavedField(record *ast.Object, name string, value *ast.Object) bool {
+	if value != Null {
+		return true
+	}
+	assigned, _ := record.Extra["assignedFields"].(map[string]bool)
+	return assigned[strings.ToLower(name)]
+}
+
+// RecordErrors returns the messages added to the record by SObject#addError
+func RecordErrors(record *ast.Object) []string {
+	if errors, ok := record.Extra["errors"].([]string); ok {
+		return errors
+	}
+	return []string{}
+}
+
 var SObjectType = &ast.ClassType{Name: "SObject"}
+
+// AggregateResultType is the record of the query with the aggregate functions or GROUP BY,
+// the values of the functions are named expr0, expr1... in order
+var AggregateResultType *ast.ClassType
 var SObjectTypeParameter = &ast.Parameter{
 	Type: SObjectType,
 	Name: "_",
@@ -123,7 +235,7 @@ func init() {
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 					key := params[0].StringValue()
-					this.InstanceFields.Set(key, params[1])
+					SetField(this, key, params[1])
 					return nil
 				},
 			),
@@ -147,6 +259,20 @@ func init() {
 			),
 		},
 	)
+	instanceMethods.Set(
+		"addError",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"addError",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["errors"] = append(RecordErrors(this), params[0].StringValue())
+					return nil
+				},
+			),
+		},
+	)
 
 	SObjectType.Constructors = []*ast.Method{}
 	SObjectType.InstanceFields = ast.NewFieldMap()
@@ -188,4 +314,13 @@ func init() {
 		)
 	}
 	primitiveClassMap.Set("SObject", SObjectType)
+	AggregateResultType = newSObjectClass(Sobject{Name: "AggregateResult"})
+	primitiveClassMap.Set("AggregateResult", AggregateResultType)
+	for name, sobj := range standardSObjects {
+		primitiveClassMap.Set(name, newSObjectClass(sobj))
+	}
+	for name, sobj := range standardSObjects {
+		classType, _ := primitiveClassMap.Get(name)
+		setRelationshipFields(classType, sobj)
+	}
 }
@@ -0,0 +1,539 @@
+package builtin
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// IsAggregateQuery returns whether the query returns AggregateResult
+func IsAggregateQuery(n *ast.Soql) bool {
+	if n.Group != nil {
+		return true
+	}
+	for _, field := range n.SelectFields {
+		if _, ok := field.(*ast.SoqlFunction); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// findSObject returns the sObject of the name in the metafile or the standard sObjects of land
+func findSObject(name string) (Sobject, bool) {
+	for sObjectName, sobject := range sObjects {
+		if strings.EqualFold(sObjectName, name) {
+			return sobject, true
+		}
+	}
+	for sObjectName, sobject := range standardSObjects {
+		if strings.EqualFold(sObjectName, name) {
+			return sobject, true
+		}
+	}
+	return Sobject{}, false
+}
+
+func sObjectClassType(name string) *ast.ClassType {
+	if classType, ok := PrimitiveClassMap().Get(name); ok {
+		return classType
+	}
+	return SObjectType
+}
+
+// soqlRow is the record or the group of the records by GROUP BY, which the conditions and the order are evaluated on
+type soqlRow interface {
+	get(field ast.Node) *ast.Object
+}
+
+type soqlRecordRow struct {
+	evaluator   *soqlEvaluator
+	sObjectType string
+	record      memoryRecord
+}
+
+func (r *soqlRecordRow) get(field ast.Node) *ast.Object {
+	if f, ok := field.(*ast.SelectField); ok {
+		return r.evaluator.fieldValue(r.sObjectType, r.record, f.Value)
+	}
+	panic(fmt.Sprintf("unsupported field %s in the condition", field.GetType()))
+}
+
+// soqlGroupRow is the group of the records, rolledUp are the fields of GROUP BY which the group is the subtotal of
+type soqlGroupRow struct {
+	rows     []soqlRow
+	rolledUp []ast.Node
+}
+
+func (g *soqlGroupRow) get(field ast.Node) *ast.Object {
+	switch f := field.(type) {
+	case *ast.SelectField:
+		if len(g.rows) == 0 || containsField(g.rolledUp, f) {
+			return Null
+		}
+		return g.rows[0].get(f)
+	case *ast.SoqlFunction:
+		if strings.EqualFold(f.Name, "grouping") {
+			if len(f.Fields) == 1 && containsField(g.rolledUp, f.Fields[0]) {
+				return NewInteger(1)
+			}
+			return NewInteger(0)
+		}
+		return aggregate(f, g.rows)
+	}
+	panic(fmt.Sprintf("unsupported field %s in the condition", field.GetType()))
+}
+
+// soqlEvaluator evaluates the SOQL query on the records of the in-memory storage
+type soqlEvaluator struct {
+	storage     *memoryStorage
+	interpreter ast.Visitor
+	values      map[ast.Node]*ast.Object // the values of the expressions, which are evaluated once for a query
+}
+
+func (e *soqlEvaluator) Evaluate(n *ast.Soql) []*ast.Object {
+	rows := []soqlRow{}
+	for _, record := range e.storage.table(n.FromObject).records {
+		row := &soqlRecordRow{evaluator: e, sObjectType: n.FromObject, record: record}
+		if e.match(n.Where, row) {
+			rows = append(rows, row)
+		}
+	}
+	aggregated := IsAggregateQuery(n)
+	if aggregated {
+		rows = e.group(n, rows)
+	}
+	if len(n.Order) > 0 {
+		sortRows(n.Order, rows)
+	}
+	if n.Offset != nil {
+		offset := e.evaluate(n.Offset).IntegerValue()
+		if offset > len(rows) {
+			offset = len(rows)
+		}
+		rows = rows[offset:]
+	}
+	if n.Limit != nil {
+		if limit := e.evaluate(n.Limit).IntegerValue(); limit < len(rows) {
+			rows = rows[:limit]
+		}
+	}
+
+	records := make([]*ast.Object, len(rows))
+	for i, row := range rows {
+		if aggregated {
+			records[i] = newAggregateResult(n, row)
+			continue
+		}
+		recordRow := row.(*soqlRecordRow)
+		record := ast.CreateObject(sObjectClassType(n.FromObject))
+		for _, field := range n.SelectFields {
+			// the subqueries are queried by QueryRecords
+			if selectField, ok := field.(*ast.SelectField); ok {
+				e.setField(record, n.FromObject, recordRow.record, trimSObjectName(n.FromObject, selectField.Value))
+			}
+		}
+		records[i] = record
+	}
+	return records
+}
+
+// evaluate returns the value of the literal or the bind variable
+func (e *soqlEvaluator) evaluate(n ast.Node) *ast.Object {
+	if value, ok := e.values[n]; ok {
+		return value
+	}
+	value, err := n.Accept(e.interpreter)
+	if err != nil {
+		panic(err)
+	}
+	e.values[n] = value.(*ast.Object)
+	return e.values[n]
+}
+
+// trimSObjectName removes the sObject name at the head of the field path, such as Account.Name on Account
+func trimSObjectName(sObjectType string, path []string) []string {
+	if len(path) > 1 && strings.EqualFold(path[0], sObjectType) {
+		return path[1:]
+	}
+	return path
+}
+
+// parent returns the record related by the relationship name, or nil if the reference is null
+func (e *soqlEvaluator) parent(sObjectType string, record memoryRecord, relationshipName string) (string, memoryRecord) {
+	sobject, _ := findSObject(sObjectType)
+	for _, field := range sobject.Fields {
+		if !strings.EqualFold(field.RelationshipName, relationshipName) || len(field.ReferenceTo) == 0 {
+			continue
+		}
+		id, ok := record[strings.ToLower(field.Name)]
+		if !ok || id == Null {
+			return field.ReferenceTo[0], nil
+		}
+		// TODO: polymorphic relation
+		return field.ReferenceTo[0], e.storage.table(field.ReferenceTo[0]).find(soqlString(id))
+	}
+	panic(fmt.Sprintf("Didn't understand relationship '%s' in field path of %s", relationshipName, sObjectType))
+}
+
+// fieldValue returns the value of the field path, traversing the parent records
+func (e *soqlEvaluator) fieldValue(sObjectType string, record memoryRecord, path []string) *ast.Object {
+	path = trimSObjectName(sObjectType, path)
+	for len(path) > 1 {
+		sObjectType, record = e.parent(sObjectType, record, path[0])
+		if record == nil {
+			return Null
+		}
+		path = path[1:]
+	}
+	if value, ok := record[strings.ToLower(path[0])]; ok {
+		return value
+	}
+	return Null
+}
+
+// setField sets the value of the field path to the result record, the parent records are nested as SObject
+func (e *soqlEvaluator) setField(obj *ast.Object, sObjectType string, record memoryRecord, path []string) {
+	if len(path) == 1 {
+		value, ok := record[strings.ToLower(path[0])]
+		if !ok {
+			value = Null
+		}
+		obj.InstanceFields.Set(path[0], value)
+		return
+	}
+	parentType, parent := e.parent(sObjectType, record, path[0])
+	if parent == nil {
+		obj.InstanceFields.Set(path[0], Null)
+		return
+	}
+	parentObj, ok := obj.InstanceFields.Get(path[0])
+	if !ok || parentObj == Null {
+		parentObj = ast.CreateObject(sObjectClassType(parentType))
+		obj.InstanceFields.Set(path[0], parentObj)
+	}
+	e.setField(parentObj, parentType, parent, path[1:])
+}
+
+func (e *soqlEvaluator) match(n ast.Node, row soqlRow) bool {
+	switch val := n.(type) {
+	case *ast.WhereBinaryOperator:
+		if strings.EqualFold(val.Op, "or") {
+			return e.match(val.Left, row) || e.match(val.Right, row)
+		}
+		return e.match(val.Left, row) && e.match(val.Right, row)
+	case *ast.WhereCondition:
+		matched := matchCondition(val.Op, row.get(val.Field), e.evaluate(val.Expression))
+		if val.Not {
+			return !matched
+		}
+		return matched
+	}
+	return true
+}
+
+func matchCondition(op string, value, expected *ast.Object) bool {
+	switch strings.ToLower(op) {
+	case "=":
+		return soqlEquals(value, expected)
+	case "!=", "<>":
+		return !soqlEquals(value, expected)
+	case "<":
+		c, ok := compareSoqlValues(value, expected)
+		return ok && c < 0
+	case ">":
+		c, ok := compareSoqlValues(value, expected)
+		return ok && c > 0
+	case "<=":
+		c, ok := compareSoqlValues(value, expected)
+		return ok && c <= 0
+	case ">=":
+		c, ok := compareSoqlValues(value, expected)
+		return ok && c >= 0
+	case "like":
+		if value == Null || expected == Null {
+			return false
+		}
+		return likePattern(soqlString(expected)).MatchString(soqlString(value))
+	case "in":
+		for _, candidate := range soqlCollection(expected) {
+			if soqlEquals(value, candidate) {
+				return true
+			}
+		}
+		return false
+	}
+	panic(fmt.Sprintf("unsupported operator %s", op))
+}
+
+// likePattern converts the pattern of LIKE, whose wildcards are % and _, to the case insensitive regexp
+func likePattern(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?is)^")
+	for _, c := range pattern {
+		switch c {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+// soqlCollection returns the values of the List or the Set for IN, the SObjects are compared by their Ids
+func soqlCollection(o *ast.Object) []*ast.Object {
+	values := []*ast.Object{}
+	switch collection := o.Extra["values"].(type) {
+	case map[string]struct{}:
+		for key := range collection {
+			values = append(values, NewString(key))
+		}
+		return values
+	case map[string]*ast.Object:
+		for _, value := range collection {
+			values = append(values, value)
+		}
+		return values
+	}
+	records, ok := o.Extra["records"].([]*ast.Object)
+	if !ok {
+		return []*ast.Object{o}
+	}
+	for _, record := range records {
+		if record != Null && Equals(SObjectType, record.ClassType) {
+			id, _ := record.InstanceFields.Get("Id")
+			record = id
+		}
+		values = append(values, record)
+	}
+	return values
+}
+
+func soqlEquals(value, expected *ast.Object) bool {
+	if value == nil || value == Null || expected == nil || expected == Null {
+		return (value == nil || value == Null) && (expected == nil || expected == Null)
+	}
+	if value.ClassType == IdType || expected.ClassType == IdType {
+		return IdEquals(soqlString(value), soqlString(expected))
+	}
+	c, ok := compareSoqlValues(value, expected)
+	return ok && c == 0
+}
+
+// soqlString returns the text of the value, which is compared case insensitively
+func soqlString(o *ast.Object) string {
+	if s, ok := o.Value().(string); ok {
+		return s
+	}
+	return String(o)
+}
+
+func soqlNumber(o *ast.Object) (float64, bool) {
+	switch v := o.Value().(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// compareSoqlValues compares the values as numbers, dates, booleans or case insensitive texts.
+// The values saved as text in SQL are compared with the numbers.
+func compareSoqlValues(a, b *ast.Object) (int, bool) {
+	if a == nil || a == Null || b == nil || b == Null {
+		return 0, false
+	}
+	_, aText := a.Value().(string)
+	_, bText := b.Value().(string)
+	if !aText || !bText {
+		if x, ok := soqlNumber(a); ok {
+			if y, ok := soqlNumber(b); ok {
+				return compareFloat(x, y), true
+			}
+		}
+	}
+	if x, ok := a.Value().(time.Time); ok {
+		if y, ok := b.Value().(time.Time); ok {
+			switch {
+			case x.Before(y):
+				return -1, true
+			case x.After(y):
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	if x, ok := a.Value().(bool); ok {
+		if y, ok := b.Value().(bool); ok {
+			switch {
+			case x == y:
+				return 0, true
+			case y:
+				return -1, true
+			}
+			return 1, true
+		}
+	}
+	return strings.Compare(strings.ToLower(soqlString(a)), strings.ToLower(soqlString(b))), true
+}
+
+func compareFloat(x, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// sortRows sorts the rows stably by ORDER BY, the nulls are first in ascending order and last in descending order by default
+func sortRows(orders []*ast.Order, rows []soqlRow) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, order := range orders {
+			a, b := rows[i].get(order.Field), rows[j].get(order.Field)
+			aNull, bNull := a == Null, b == Null
+			if aNull || bNull {
+				if aNull == bNull {
+					continue
+				}
+				return aNull == nullsFirst(order)
+			}
+			c, _ := compareSoqlValues(a, b)
+			if c == 0 {
+				continue
+			}
+			return (c < 0) == order.Asc
+		}
+		return false
+	})
+}
+
+// nullsFirst returns whether the nulls are sorted before the other values, which follows the direction by default
+func nullsFirst(order *ast.Order) bool {
+	if order.Nulls != "" {
+		return strings.EqualFold(order.Nulls, "first")
+	}
+	return order.Asc
+}
+
+// group groups the rows by GROUP BY and filters the groups by HAVING.
+// Without GROUP BY, all rows are aggregated into one group.
+// With ROLLUP and CUBE, the groups of each grouping set follow in order, so the grand total is the last.
+func (e *soqlEvaluator) group(n *ast.Soql, rows []soqlRow) []soqlRow {
+	if n.Group == nil {
+		return []soqlRow{&soqlGroupRow{rows: rows}}
+	}
+	groups := []soqlRow{}
+	fields, sets := groupingSets(n.Group)
+	for _, set := range sets {
+		rolledUp := rolledUpFields(fields, set)
+		groupMap := map[string]*soqlGroupRow{}
+		for _, row := range rows {
+			keys := make([]string, len(set))
+			for i, field := range set {
+				value := row.get(field)
+				if value == Null {
+					keys[i] = "\x00"
+				} else {
+					keys[i] = strings.ToLower(soqlString(value))
+				}
+			}
+			key := strings.Join(keys, "\x01")
+			group, ok := groupMap[key]
+			if !ok {
+				group = &soqlGroupRow{rolledUp: rolledUp}
+				groupMap[key] = group
+				groups = append(groups, group)
+			}
+			group.rows = append(group.rows, row)
+		}
+	}
+	if n.Group.Having == nil {
+		return groups
+	}
+	filtered := []soqlRow{}
+	for _, group := range groups {
+		if e.match(n.Group.Having, group) {
+			filtered = append(filtered, group)
+		}
+	}
+	return filtered
+}
+
+func newAggregateResult(n *ast.Soql, row soqlRow) *ast.Object {
+	result := ast.CreateObject(AggregateResultType)
+	for i, name := range AggregateFieldNames(n) {
+		result.InstanceFields.Set(name, row.get(n.SelectFields[i]))
+	}
+	return result
+}
+
+// aggregate calculates the aggregate function on the rows of the group
+func aggregate(f *ast.SoqlFunction, rows []soqlRow) *ast.Object {
+	name := strings.ToLower(f.Name)
+	if len(f.Fields) == 0 {
+		if name == "count" {
+			return NewInteger(len(rows))
+		}
+		panic(fmt.Sprintf("%s() requires a field", f.Name))
+	}
+	values := []*ast.Object{}
+	for _, row := range rows {
+		if value := row.get(f.Fields[0]); value != Null {
+			values = append(values, value)
+		}
+	}
+	switch name {
+	case "count":
+		return NewInteger(len(values))
+	case "count_distinct":
+		distinct := map[string]bool{}
+		for _, value := range values {
+			distinct[strings.ToLower(soqlString(value))] = true
+		}
+		return NewInteger(len(distinct))
+	case "sum", "avg":
+		if len(values) == 0 {
+			return Null
+		}
+		sum := 0.0
+		for _, value := range values {
+			number, ok := soqlNumber(value)
+			if !ok {
+				panic(fmt.Sprintf("%s() requires a number field", f.Name))
+			}
+			sum += number
+		}
+		if name == "avg" {
+			return NewDouble(sum / float64(len(values)))
+		}
+		return NewDouble(sum)
+	case "min", "max":
+		if len(values) == 0 {
+			return Null
+		}
+		result := values[0]
+		for _, value := range values[1:] {
+			c, _ := compareSoqlValues(value, result)
+			if (name == "min" && c < 0) || (name == "max" && c > 0) {
+				result = value
+			}
+		}
+		return result
+	}
+	panic(fmt.Sprintf("unsupported aggregate function %s", f.Name))
+}
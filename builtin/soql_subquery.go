@@ -0,0 +1,140 @@
+package builtin
+
+import (
+	"fmt"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// QueryRecords queries the records of the storage with the child records of the subqueries,
+// such as SELECT Id, (SELECT Id FROM Contacts) FROM Account, which are set to the parent records as the lists
+func QueryRecords(n *ast.Soql, interpreter ast.Visitor) ([]*ast.Object, error) {
+	subqueries := []*ast.Soql{}
+	for _, field := range n.SelectFields {
+		if subquery, ok := field.(*ast.Soql); ok {
+			subqueries = append(subqueries, subquery)
+		}
+	}
+	if len(subqueries) == 0 || IsAggregateQuery(n) {
+		return DatabaseDriver.Query(n, interpreter)
+	}
+	query := withField(n, "Id")
+	records, err := DatabaseDriver.Query(query, interpreter)
+	if err != nil {
+		return nil, err
+	}
+	for _, subquery := range subqueries {
+		if err := queryChildRecords(n.FromObject, subquery, records, interpreter); err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
+// withField returns the copy of the query which selects the field, or the query itself if it is selected
+func withField(n *ast.Soql, fieldName string) *ast.Soql {
+	field := &ast.SelectField{Value: []string{fieldName}}
+	if containsField(n.SelectFields, field) {
+		return n
+	}
+	query := *n
+	query.SelectFields = append([]ast.Node{field}, n.SelectFields...)
+	return &query
+}
+
+// queryChildRecords queries the child records of all parents at once, and distributes them to the parents in order.
+// LIMIT and OFFSET of the subquery apply to the child records of each parent.
+func queryChildRecords(parentType string, subquery *ast.Soql, parents []*ast.Object, interpreter ast.Visitor) error {
+	sObject, _ := findSObject(parentType)
+	relationship, ok := findChildRelationship(sObject, subquery.FromObject)
+	if !ok {
+		return fmt.Errorf("Didn't understand relationship '%s' in FROM part of query call.", subquery.FromObject)
+	}
+	childType := sObjectClassType(relationship.ChildSObject)
+	ids := make([]*ast.Object, len(parents))
+	for i, parent := range parents {
+		ids[i], _ = parent.InstanceFields.Get("Id")
+	}
+
+	query, evaluator := restrictQuery(withField(subquery, relationship.Field), relationship.Field, CreateListObject(IdType, ids), interpreter)
+	query.FromObject = relationship.ChildSObject
+	query.Limit = nil
+	query.Offset = nil
+	children := map[string][]*ast.Object{}
+	if len(ids) > 0 {
+		records, err := DatabaseDriver.Query(query, evaluator)
+		if err != nil {
+			return err
+		}
+		for _, child := range records {
+			id, _ := child.InstanceFields.Get(relationship.Field)
+			children[soqlString(id)] = append(children[soqlString(id)], child)
+		}
+	}
+
+	offset, limit := 0, -1
+	if subquery.Offset != nil {
+		offset = evaluateInteger(subquery.Offset, interpreter)
+	}
+	if subquery.Limit != nil {
+		limit = evaluateInteger(subquery.Limit, interpreter)
+	}
+	for i, parent := range parents {
+		records := children[soqlString(ids[i])]
+		if offset > len(records) {
+			offset = len(records)
+		}
+		records = records[offset:]
+		if limit >= 0 && limit < len(records) {
+			records = records[:limit]
+		}
+		parent.InstanceFields.Set(relationship.RelationshipName, CreateListObject(childType, records))
+	}
+	return nil
+}
+
+// evaluateInteger returns the number of LIMIT or OFFSET, which may be the bind variable
+func evaluateInteger(n ast.Node, interpreter ast.Visitor) int {
+	value, err := n.Accept(interpreter)
+	if err != nil {
+		panic(err)
+	}
+	return value.(*ast.Object).IntegerValue()
+}
+
+// restrictQuery returns the copy of the query whose records are restricted to the field IN the values,
+// and the visitor which evaluates the bind variable of the values and the others by the interpreter
+func restrictQuery(n *ast.Soql, field string, values *ast.Object, interpreter ast.Visitor) (*ast.Soql, ast.Visitor) {
+	query := *n
+	variable := &ast.SoqlBindVariable{}
+	condition := &ast.WhereCondition{
+		Field:      &ast.SelectField{Value: []string{field}},
+		Op:         "IN",
+		Expression: variable,
+	}
+	if query.Where == nil {
+		query.Where = condition
+	} else {
+		query.Where = &ast.WhereBinaryOperator{Left: condition, Right: query.Where, Op: "AND"}
+	}
+	return &query, &restrictedQueryEvaluator{
+		Visitor:  interpreter,
+		variable: variable,
+		values:   values,
+	}
+}
+
+// restrictedQueryEvaluator evaluates the bind variable of the values restricting the query,
+// and the other expressions by the interpreter
+type restrictedQueryEvaluator struct {
+	ast.Visitor
+	variable *ast.SoqlBindVariable
+	values   *ast.Object
+}
+
+func (v *restrictedQueryEvaluator) VisitSoqlBindVariable(n *ast.SoqlBindVariable) (interface{}, error) {
+	if n == v.variable {
+		return v.values, nil
+	}
+	return v.Visitor.VisitSoqlBindVariable(n)
+}
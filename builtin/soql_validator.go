@@ -0,0 +1,119 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// ValidateQuery checks that the fields of SELECT, WHERE, GROUP BY, HAVING and ORDER BY are the fields of the sObject,
+// following the relationship paths to the parent sObjects and the subqueries to the child sObjects.
+// The message of the error is that of QueryException. The fields of the sObjects not in the metafile are not checked.
+func ValidateQuery(n *ast.Soql) error {
+	sObject, ok := findSObject(n.FromObject)
+	if !ok {
+		return nil
+	}
+	return validateQuery(n, sObject)
+}
+
+func validateQuery(n *ast.Soql, sObject Sobject) error {
+	fields := []ast.Node{}
+	for _, field := range n.SelectFields {
+		subquery, ok := field.(*ast.Soql)
+		if !ok {
+			fields = append(fields, field)
+			continue
+		}
+		relationship, ok := findChildRelationship(sObject, subquery.FromObject)
+		if !ok {
+			return fmt.Errorf("Didn't understand relationship '%s' in FROM part of query call.", subquery.FromObject)
+		}
+		if child, ok := findSObject(relationship.ChildSObject); ok {
+			if err := validateQuery(subquery, child); err != nil {
+				return err
+			}
+		}
+	}
+	conditions := []ast.Node{n.Where}
+	if n.Group != nil {
+		fields = append(fields, n.Group.Fields...)
+		conditions = append(conditions, n.Group.Having)
+	}
+	for _, order := range n.Order {
+		fields = append(fields, order.Field)
+	}
+	for _, field := range fields {
+		if err := validateField(sObject, field); err != nil {
+			return err
+		}
+	}
+	for _, condition := range conditions {
+		if err := validateCondition(sObject, condition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateCondition checks the fields of the conditions of WHERE or HAVING
+func validateCondition(sObject Sobject, n ast.Node) error {
+	switch c := n.(type) {
+	case *ast.WhereBinaryOperator:
+		if err := validateCondition(sObject, c.Left); err != nil {
+			return err
+		}
+		return validateCondition(sObject, c.Right)
+	case *ast.WhereCondition:
+		return validateField(sObject, c.Field)
+	}
+	return nil
+}
+
+// validateField checks the field path, or the fields of the function such as COUNT(Id) and ROLLUP(Name)
+func validateField(sObject Sobject, n ast.Node) error {
+	switch f := n.(type) {
+	case *ast.SelectField:
+		return validateFieldPath(sObject, trimSObjectName(sObject.Name, f.Value))
+	case *ast.SoqlFunction:
+		for _, field := range f.Fields {
+			if err := validateField(sObject, field); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateFieldPath checks that the relationship names of the path lead to the sObject which has the last field
+func validateFieldPath(sObject Sobject, path []string) error {
+	last := len(path) - 1
+	for _, relationshipName := range path[:last] {
+		field, ok := findRelationshipField(sObject.Name, relationshipName)
+		if !ok {
+			return fmt.Errorf("Didn't understand relationship '%s' in field path.", relationshipName)
+		}
+		parent, ok := findSObject(field.ReferenceTo[0])
+		if !ok {
+			return nil
+		}
+		sObject = parent
+	}
+	for _, field := range sObject.Fields {
+		if strings.EqualFold(field.Name, path[last]) {
+			return nil
+		}
+	}
+	return fmt.Errorf("No such column '%s' on entity '%s'.", path[last], sObject.Name)
+}
+
+// findChildRelationship returns the child relationship of the name in FROM of the subquery, which is case insensitive
+func findChildRelationship(sObject Sobject, relationshipName string) (ChildRelationship, bool) {
+	for _, relationship := range sObject.ChildRelationships {
+		if strings.EqualFold(relationship.RelationshipName, relationshipName) {
+			return relationship, true
+		}
+	}
+	return ChildRelationship{}, false
+}
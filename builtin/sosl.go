@@ -0,0 +1,239 @@
+package builtin
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// SearchGroups are the field groups of IN ... FIELDS in SOSL, the records are searched in ALL FIELDS by default
+var SearchGroups = []string{"ALL", "NAME", "EMAIL", "PHONE", "SIDEBAR"}
+
+// nameFields are the fields searched in NAME FIELDS, which are the compound name of Contact and Lead and Name of the others
+var nameFields = []string{"Name", "FirstName", "LastName"}
+
+// SearchRecords searches the records of the objects of RETURNING, such as FIND 'Acme*' IN NAME FIELDS RETURNING Account(Name), Contact,
+// and returns List<List<SObject>> whose lists are the records in the order of RETURNING.
+// The records matching the term are restricted by WHERE and LIMIT of each object.
+// If fixedIds is not nil, which is set by Test.setFixedSearchResults, the records of the Ids are returned regardless of the term.
+func SearchRecords(n *ast.Sosl, term string, fixedIds []string, interpreter ast.Visitor) (*ast.Object, error) {
+	clauses := parseSearchTerm(term)
+	lists := make([]*ast.Object, len(n.Returning))
+	for i, returning := range n.Returning {
+		ids := fixedIds
+		if ids == nil {
+			index, err := newSearchIndex(returning.FromObject, searchFields(returning.FromObject, n.SearchGroup), interpreter)
+			if err != nil {
+				return nil, err
+			}
+			ids = index.search(clauses)
+		}
+		values := make([]*ast.Object, len(ids))
+		for j, id := range ids {
+			values[j] = NewString(id)
+		}
+		records := []*ast.Object{}
+		if len(ids) > 0 {
+			query, evaluator := restrictQuery(returning, "Id", CreateListObject(IdType, values), interpreter)
+			var err error
+			records, err = QueryRecords(query, evaluator)
+			if err != nil {
+				return nil, err
+			}
+		}
+		lists[i] = newTypedList(sObjectClassType(returning.FromObject), records)
+	}
+	return newTypedList(CreateListType(SObjectType), lists), nil
+}
+
+// newTypedList returns the list of the element type, which can be cast to the list of the concrete type such as (List<Account>)
+func newTypedList(classType *ast.ClassType, records []*ast.Object) *ast.Object {
+	return &ast.Object{
+		ClassType:      CreateListType(classType),
+		InstanceFields: ast.NewObjectMap(),
+		Extra: map[string]interface{}{
+			"records": records,
+		},
+	}
+}
+
+// searchFields returns the text fields of the sObject which are searched in the search group
+func searchFields(sObjectType, group string) []string {
+	sObject, _ := findSObject(sObjectType)
+	fields := []string{}
+	for _, field := range sObject.Fields {
+		var searchable bool
+		switch group {
+		case "NAME":
+			for _, name := range nameFields {
+				searchable = searchable || strings.EqualFold(field.Name, name)
+			}
+		case "EMAIL":
+			searchable = field.Type == "email"
+		case "PHONE":
+			searchable = field.Type == "phone"
+		default:
+			switch field.Type {
+			case "string", "textarea", "picklist", "multipicklist", "combobox", "email", "phone", "url":
+				searchable = true
+			}
+		}
+		if searchable {
+			fields = append(fields, field.Name)
+		}
+	}
+	return fields
+}
+
+// searchIndex is the in-process full-text index of the records of an sObject,
+// which maps the lower-cased words of the text fields to the Ids of the records
+type searchIndex struct {
+	words map[string]map[string]bool
+	ids   []string
+}
+
+func newSearchIndex(sObjectType string, fields []string, interpreter ast.Visitor) (*searchIndex, error) {
+	index := &searchIndex{words: map[string]map[string]bool{}}
+	query := &ast.Soql{
+		FromObject:   sObjectType,
+		SelectFields: []ast.Node{&ast.SelectField{Value: []string{"Id"}}},
+	}
+	for _, field := range fields {
+		query = withField(query, field)
+	}
+	records, err := DatabaseDriver.Query(query, interpreter)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		id, _ := record.InstanceFields.Get("Id")
+		index.ids = append(index.ids, soqlString(id))
+		for _, field := range fields {
+			value, ok := record.InstanceFields.Get(field)
+			if !ok || value == Null {
+				continue
+			}
+			for _, word := range searchWords(soqlString(value)) {
+				if index.words[word] == nil {
+					index.words[word] = map[string]bool{}
+				}
+				index.words[word][soqlString(id)] = true
+			}
+		}
+	}
+	return index, nil
+}
+
+// search returns the Ids of the records matching any of the clauses, in the order of the records
+func (i *searchIndex) search(clauses []searchClause) []string {
+	ids := []string{}
+	for _, id := range i.ids {
+		for _, clause := range clauses {
+			if i.matches(id, clause) {
+				ids = append(ids, id)
+				break
+			}
+		}
+	}
+	return ids
+}
+
+// matches returns whether the record contains all words of the clause and none of the excluded words
+func (i *searchIndex) matches(id string, clause searchClause) bool {
+	if len(clause.words) == 0 {
+		return false
+	}
+	for _, word := range clause.words {
+		if !i.contains(id, word) {
+			return false
+		}
+	}
+	for _, word := range clause.excluded {
+		if i.contains(id, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// contains returns whether the record has the word, which may have the wildcards * and ?
+func (i *searchIndex) contains(id string, word string) bool {
+	if !strings.ContainsAny(word, "*?") {
+		return i.words[word][id]
+	}
+	pattern := regexp.QuoteMeta(word)
+	pattern = strings.Replace(pattern, `\*`, ".*", -1)
+	pattern = strings.Replace(pattern, `\?`, ".", -1)
+	re := regexp.MustCompile("^" + pattern + "$")
+	for w, ids := range i.words {
+		if ids[id] && re.MatchString(w) {
+			return true
+		}
+	}
+	return false
+}
+
+// searchClause is the words joined by AND in the search term, the clauses are joined by OR
+type searchClause struct {
+	words    []string
+	excluded []string
+}
+
+// parseSearchTerm parses the search term such as 'Acme* OR "Global Media" AND NOT Corp'.
+// The words separated by spaces are joined by AND, and the phrase quoted by " matches the records containing all of its words.
+func parseSearchTerm(term string) []searchClause {
+	clauses := []searchClause{{}}
+	not := false
+	for len(term) > 0 {
+		term = strings.TrimLeftFunc(term, unicode.IsSpace)
+		if term == "" {
+			break
+		}
+		var token string
+		quoted := term[0] == '"'
+		if quoted {
+			end := strings.IndexByte(term[1:], '"')
+			if end < 0 {
+				token, term = term[1:], ""
+			} else {
+				token, term = term[1:end+1], term[end+2:]
+			}
+		} else {
+			end := strings.IndexFunc(term, unicode.IsSpace)
+			if end < 0 {
+				token, term = term, ""
+			} else {
+				token, term = term[:end], term[end:]
+			}
+		}
+		if !quoted {
+			switch token {
+			case "AND":
+				continue
+			case "OR":
+				clauses = append(clauses, searchClause{})
+				continue
+			case "NOT":
+				not = true
+				continue
+			}
+		}
+		clause := &clauses[len(clauses)-1]
+		if not {
+			clause.excluded = append(clause.excluded, searchWords(token)...)
+		} else {
+			clause.words = append(clause.words, searchWords(token)...)
+		}
+		not = false
+	}
+	return clauses
+}
+
+// searchWords splits the text into the lower-cased words, which keep the wildcards of the search term
+func searchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '*' && r != '?'
+	})
+}
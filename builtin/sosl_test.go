@@ -0,0 +1,66 @@
+package builtin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSearchTerm(t *testing.T) {
+	testCases := []struct {
+		Term     string
+		Expected []searchClause
+	}{
+		{
+			"Acme",
+			[]searchClause{{words: []string{"acme"}}},
+		},
+		{
+			"Acme Corp*",
+			[]searchClause{{words: []string{"acme", "corp*"}}},
+		},
+		{
+			`"Global Media" OR wile@acme.com`,
+			[]searchClause{
+				{words: []string{"global", "media"}},
+				{words: []string{"wile", "acme", "com"}},
+			},
+		},
+		{
+			"Acme AND NOT Globex",
+			[]searchClause{{words: []string{"acme"}, excluded: []string{"globex"}}},
+		},
+	}
+	for _, testCase := range testCases {
+		actual := parseSearchTerm(testCase.Term)
+		if !reflect.DeepEqual(actual, testCase.Expected) {
+			t.Errorf("%s: expected %#v, actual %#v", testCase.Term, testCase.Expected, actual)
+		}
+	}
+}
+
+func TestSearchIndexSearch(t *testing.T) {
+	index := &searchIndex{
+		words: map[string]map[string]bool{
+			"acme":        {"001A": true},
+			"corporation": {"001A": true},
+			"globex":      {"001B": true},
+		},
+		ids: []string{"001A", "001B"},
+	}
+	testCases := []struct {
+		Word     string
+		Expected []string
+	}{
+		{"acme", []string{"001A"}},
+		{"corp*", []string{"001A"}},
+		{"glob?x", []string{"001B"}},
+		{"*o*", []string{"001A", "001B"}},
+		{"corp", []string{}},
+	}
+	for _, testCase := range testCases {
+		actual := index.search([]searchClause{{words: []string{testCase.Word}}})
+		if !reflect.DeepEqual(actual, testCase.Expected) {
+			t.Errorf("%s: expected %v, actual %v", testCase.Word, testCase.Expected, actual)
+		}
+	}
+}
@@ -2,67 +2,224 @@ package builtin
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/tzmfreedom/land/ast"
 )
 
+// Relation is the parent table joined by the relationship field of the table Parent, which is t0 or the other relation
 type Relation struct {
 	RelationshipName string
 	FieldName        string
 	ReferenceTo      string
+	Parent           string
 }
 
 type SqlBuilder struct {
 	interpreter ast.Visitor
+	dialect     dialect
+	args        []interface{}
 }
 
-func (b *SqlBuilder) Build(n *ast.Soql) (string, [][]string, map[string]Relation) {
+// getDialect returns the dialect of the storage, SQLite by default
+func (b *SqlBuilder) getDialect() dialect {
+	if b.dialect == nil {
+		return sqliteDialect{}
+	}
+	return b.dialect
+}
+
+// quote quotes the table or column name by the dialect of the storage
+func (b *SqlBuilder) quote(identifier string) string {
+	return b.getDialect().Quote(identifier)
+}
+
+// bind adds the value to the arguments of the query and returns its placeholder
+func (b *SqlBuilder) bind(value *ast.Object) string {
+	b.args = append(b.args, fieldText(value))
+	return "?"
+}
+
+// evaluate returns the value of the literal or the bind variable
+func (b *SqlBuilder) evaluate(n ast.Node) *ast.Object {
+	value, err := n.Accept(b.interpreter)
+	if err != nil {
+		panic(err)
+	}
+	return value.(*ast.Object)
+}
+
+// Build returns the query with the placeholders and the arguments bound to them,
+// the literals and the bind variables in the conditions are never inlined.
+// The error is returned if the query can not be built into SQL.
+func (b *SqlBuilder) Build(n *ast.Soql) (string, []interface{}, [][]string, map[string]Relation, error) {
+	b.args = []interface{}{}
 	tmpTableMap := map[string]string{}
-	selectClause, selectFields := createSelectClause(n, tmpTableMap)
+	if IsGroupingSets(n.Group) {
+		return b.buildGroupingSets(n, tmpTableMap)
+	}
+	columns, selectFields := b.createSelectClause(n, tmpTableMap, nil)
 	whereClause := b.createWhere(n.Where, tmpTableMap)
 	if whereClause != "" {
 		whereClause = " WHERE " + whereClause
 	}
-	groupByClause := b.createGroupBy(n.Group.Fields, tmpTableMap)
-	havingClause := b.createHaving(n.Group.Having, tmpTableMap)
-	if havingClause != "" {
-		whereClause = " HAVING " + havingClause
+	var groupByClause, havingClause string
+	if n.Group != nil {
+		groupByClause = b.createGroupBy(n.Group.Fields, tmpTableMap)
+		havingClause = b.createHaving(n.Group.Having, tmpTableMap)
+		if havingClause != "" {
+			havingClause = " HAVING " + havingClause
+		}
 	}
+	orderByClause := b.createOrderBy(n.Order, func(field ast.Node) string {
+		return b.createField(field, tmpTableMap)
+	})
+	limitClause := b.createLimit(n.Limit, n.Offset)
 
-	relations := createRelations(n.FromObject, tmpTableMap)
+	relations, err := createRelations(n.FromObject, tmpTableMap)
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
 
-	leftJoinClause := createLeftJoins(relations)
+	leftJoinClause := b.createLeftJoins(relations)
 
 	sql := fmt.Sprintf(
-		"SELECT %s FROM %s t0%s%s%s%s",
-		selectClause,
-		n.FromObject,
+		"SELECT %s FROM %s t0%s%s%s%s%s%s",
+		strings.Join(columns, ", "),
+		b.quote(n.FromObject),
 		leftJoinClause,
 		whereClause,
 		groupByClause,
 		havingClause,
+		orderByClause,
+		limitClause,
 	)
-	return sql, selectFields, relations
+	return sql, b.args, selectFields, relations, nil
+}
+
+// buildGroupingSets unions the queries grouped by each grouping set of ROLLUP and CUBE, as SQLite supports neither.
+// The columns are named c0, c1... so that ORDER BY sorts the union by the select fields.
+func (b *SqlBuilder) buildGroupingSets(n *ast.Soql, tmpTableMap map[string]string) (string, []interface{}, [][]string, map[string]Relation, error) {
+	if err := GroupingSetsOrderError(n); err != nil {
+		return "", nil, nil, nil, err
+	}
+	fields, sets := groupingSets(n.Group)
+	var selectFields [][]string
+	selectClauses := make([]string, len(sets))
+	conditionClauses := make([]string, len(sets))
+	filters := make([]bool, len(sets))
+	for i, set := range sets {
+		columns, setFields := b.createSelectClause(n, tmpTableMap, rolledUpFields(fields, set))
+		for j := range columns {
+			columns[j] = fmt.Sprintf("%s AS c%d", columns[j], j)
+		}
+		selectFields = setFields
+		selectClauses[i] = strings.Join(columns, ", ")
+
+		groupByClause := b.createGroupBy(set, tmpTableMap)
+		var havingClause string
+		if groupByClause == "" {
+			// the grand total is filtered outside, as SQLite does not allow HAVING without GROUP BY.
+			// The condition is selected before WHERE, so its arguments are bound first.
+			if condition := b.createHaving(n.Group.Having, tmpTableMap); condition != "" {
+				selectClauses[i] += fmt.Sprintf(", (%s) AS h", condition)
+				filters[i] = true
+			}
+		}
+		whereClause := b.createWhere(n.Where, tmpTableMap)
+		if whereClause != "" {
+			whereClause = " WHERE " + whereClause
+		}
+		if groupByClause != "" {
+			havingClause = b.createHaving(n.Group.Having, tmpTableMap)
+		}
+		if havingClause != "" {
+			havingClause = " HAVING " + havingClause
+		}
+		conditionClauses[i] = whereClause + groupByClause + havingClause
+	}
+	orderByClause := b.createOrderBy(n.Order, func(field ast.Node) string {
+		for i, selectField := range n.SelectFields {
+			if sameField(selectField, field) {
+				return fmt.Sprintf("u.c%d", i)
+			}
+		}
+		// never reached, GroupingSetsOrderError checks that the fields of ORDER BY are selected
+		return ""
+	})
+	limitClause := b.createLimit(n.Limit, n.Offset)
+
+	relations, err := createRelations(n.FromObject, tmpTableMap)
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
+
+	leftJoinClause := b.createLeftJoins(relations)
+
+	queries := make([]string, len(sets))
+	for i := range sets {
+		queries[i] = fmt.Sprintf(
+			"SELECT %s FROM %s t0%s%s",
+			selectClauses[i],
+			b.quote(n.FromObject),
+			leftJoinClause,
+			conditionClauses[i],
+		)
+		if filters[i] {
+			columns := make([]string, len(selectFields))
+			for j := range columns {
+				columns[j] = fmt.Sprintf("g.c%d", j)
+			}
+			queries[i] = fmt.Sprintf("SELECT %s FROM (%s) g WHERE g.h", strings.Join(columns, ", "), queries[i])
+		}
+	}
+	sql := fmt.Sprintf("SELECT * FROM (%s) u%s%s", strings.Join(queries, " UNION ALL "), orderByClause, limitClause)
+	return sql, b.args, selectFields, relations, nil
+}
+
+// tmpTable returns the alias of the table joined by the relationship path such as Account.Owner,
+// which is t1, t2, ... in order of appearance. The tables of the parent paths are joined before.
+func tmpTable(path []string, tmpTableMap map[string]string) string {
+	var alias string
+	for i := range path {
+		key := strings.ToLower(strings.Join(path[:i+1], "."))
+		if tmpTable, ok := tmpTableMap[key]; ok {
+			alias = tmpTable
+			continue
+		}
+		alias = fmt.Sprintf("t%d", len(tmpTableMap)+1)
+		tmpTableMap[key] = alias
+	}
+	return alias
+}
+
+// createField returns the column of the field path or the aggregate function of the columns
+func (b *SqlBuilder) createField(n ast.Node, tmpTableMap map[string]string) string {
+	switch f := n.(type) {
+	case *ast.SelectField:
+		if len(f.Value) == 1 {
+			return fmt.Sprintf("t0.%s", b.quote(f.Value[0]))
+		}
+		last := len(f.Value) - 1
+		return fmt.Sprintf("%s.%s", tmpTable(f.Value[:last], tmpTableMap), b.quote(f.Value[last]))
+	case *ast.SoqlFunction:
+		if len(f.Fields) == 0 {
+			return "COUNT(*)"
+		}
+		field := b.createField(f.Fields[0], tmpTableMap)
+		if strings.EqualFold(f.Name, "count_distinct") {
+			return fmt.Sprintf("COUNT(DISTINCT %s)", field)
+		}
+		return fmt.Sprintf("%s(%s)", strings.ToUpper(f.Name), field)
+	}
+	panic(fmt.Sprintf("unsupported field %s", n.GetType()))
 }
 
 func (b *SqlBuilder) createGroupBy(groups []ast.Node, tmpTableMap map[string]string) string {
 	groupFields := make([]string, len(groups))
-	// TODO: case insensitive
 	for i, group := range groups {
-		v := group.(*ast.SelectField).Value
-		if len(v) == 1 {
-			groupFields[i] = fmt.Sprintf("t0.%s", v[0])
-		} else {
-			tmpTable, ok := tmpTableMap[v[0]]
-			if !ok {
-				// TODO: key from string to integer?
-				tmpTableIndex := len(tmpTable) + 1
-				tmpTable = fmt.Sprintf("t%d", tmpTableIndex)
-				tmpTableMap[v[0]] = tmpTable
-			}
-			groupFields[i] = fmt.Sprintf("%s.%s", tmpTable, strings.Join(v[1:], "."))
-		}
+		groupFields[i] = b.createField(group, tmpTableMap)
 	}
 	if len(groupFields) == 0 {
 		return ""
@@ -74,103 +231,209 @@ func (b *SqlBuilder) createHaving(n ast.Node, tmpTableMap map[string]string) str
 	return b.createWhere(n, tmpTableMap)
 }
 
+// createOrderBy sorts the nulls explicitly, as the null ordering differs between the backends.
+// The nulls are first in ascending order and last in descending order by default like Salesforce.
+func (b *SqlBuilder) createOrderBy(orders []*ast.Order, column func(ast.Node) string) string {
+	if len(orders) == 0 {
+		return ""
+	}
+	orderFields := make([]string, len(orders))
+	for i, order := range orders {
+		field := column(order.Field)
+		nulls := "ASC"
+		if nullsFirst(order) {
+			nulls = "DESC"
+		}
+		direction := "ASC"
+		if !order.Asc {
+			direction = "DESC"
+		}
+		orderFields[i] = fmt.Sprintf("%s IS NULL %s, %s %s", field, nulls, field, direction)
+	}
+	return fmt.Sprintf(" ORDER BY %s", strings.Join(orderFields, ", "))
+}
+
+// createLimit binds the numbers of LIMIT and OFFSET, which may be the bind variables
+func (b *SqlBuilder) createLimit(limit, offset ast.Node) string {
+	var limitPlaceholder, offsetPlaceholder string
+	if limit != nil {
+		b.args = append(b.args, b.evaluate(limit).IntegerValue())
+		limitPlaceholder = "?"
+	}
+	if offset != nil {
+		b.args = append(b.args, b.evaluate(offset).IntegerValue())
+		offsetPlaceholder = "?"
+	}
+	return b.getDialect().Limit(limitPlaceholder, offsetPlaceholder)
+}
+
 func (b *SqlBuilder) createWhere(n ast.Node, tmpTableMap map[string]string) string {
 	switch val := n.(type) {
 	case *ast.WhereCondition:
-		var field string
-		switch f := val.Field.(type) {
-		case *ast.SelectField:
-			if len(f.Value) == 1 {
-				field = fmt.Sprintf("t0.%s", f.Value[0])
-			} else {
-				tmpTable, ok := tmpTableMap[f.Value[0]]
-				if !ok {
-					// TODO: key from string to integer?
-					tmpTableIndex := len(tmpTable) + 1
-					tmpTable = fmt.Sprintf("t%d", tmpTableIndex)
-					tmpTableMap[f.Value[0]] = tmpTable
-				}
-				field = fmt.Sprintf("%s.%s", tmpTable, strings.Join(f.Value[1:], "."))
-			}
-		case *ast.SoqlFunction:
-			field = f.Name + "()"
+		field := b.createField(val.Field, tmpTableMap)
+		value := b.evaluate(val.Expression)
+		var condition string
+		if _, ok := val.Field.(*ast.SoqlFunction); ok && (value.ClassType == IntegerType || value.ClassType == DoubleType) {
+			// the values of the aggregate functions have no column type which the text is converted to
+			b.args = append(b.args, value.Value())
+			condition = fmt.Sprintf("%s %s ?", field, val.Op)
+		} else {
+			condition = b.createCondition(field, val.Op, value)
 		}
-		value, err := val.Expression.Accept(b.interpreter)
-		if err != nil {
-			panic(err)
+		if val.Not {
+			return fmt.Sprintf("NOT (%s)", condition)
 		}
-		return fmt.Sprintf("%s %s '%s'", field, val.Op, String(value.(*ast.Object)))
+		return condition
 	case *ast.WhereBinaryOperator:
-		where := ""
-		if val.Left != nil {
-			where += b.createWhere(val.Left, tmpTableMap)
+		if val.Left == nil {
+			return b.createWhere(val.Right, tmpTableMap)
 		}
-		if val.Right != nil {
-			where += fmt.Sprintf("%s %s", val.Op, b.createWhere(val.Right, tmpTableMap))
+		if val.Right == nil {
+			return b.createWhere(val.Left, tmpTableMap)
 		}
-		return where
+		return fmt.Sprintf(
+			"(%s %s %s)",
+			b.createWhere(val.Left, tmpTableMap),
+			val.Op,
+			b.createWhere(val.Right, tmpTableMap),
+		)
 	}
 	return ""
 }
 
-func createSelectClause(n *ast.Soql, tmpTableMap map[string]string) (string, [][]string) {
-	selectFields := make([][]string, len(n.SelectFields))
-	// TODO: case insensitive
-	tmpTableIndex := 1
-	for i, selectField := range n.SelectFields {
-		v := selectField.(*ast.SelectField).Value
-		if len(v) == 1 {
-			selectFields[i] = []string{"t0", v[0]}
-		} else {
-			relationshipName := v[0]
-			if _, ok := tmpTableMap[relationshipName]; !ok {
-				tmpTableMap[relationshipName] = fmt.Sprintf("t%d", tmpTableIndex)
-				tmpTableIndex++
+// createCondition compares the field with the placeholders of the value,
+// the comparison with null is IS NULL and the collection of IN is expanded to the placeholders
+func (b *SqlBuilder) createCondition(field, op string, value *ast.Object) string {
+	if strings.EqualFold(op, "in") {
+		placeholders := []string{}
+		for _, candidate := range soqlCollection(value) {
+			if candidate != Null {
+				placeholders = append(placeholders, b.bind(candidate))
+			}
+		}
+		if len(placeholders) == 0 {
+			return "1 = 0"
+		}
+		return fmt.Sprintf("%s IN (%s)", field, strings.Join(placeholders, ", "))
+	}
+	if value == Null {
+		switch op {
+		case "=":
+			return fmt.Sprintf("%s IS NULL", field)
+		case "!=", "<>":
+			return fmt.Sprintf("%s IS NOT NULL", field)
+		}
+	}
+	return fmt.Sprintf("%s %s %s", field, op, b.bind(value))
+}
+
+// createSelectClause returns the columns of the select fields and the tables and the names of the fields.
+// The aggregate functions are the tables and the names of their fields followed by the function names,
+// and the rolled up fields of ROLLUP and CUBE are null.
+func (b *SqlBuilder) createSelectClause(n *ast.Soql, tmpTableMap map[string]string, rolledUp []ast.Node) ([]string, [][]string) {
+	columns := []string{}
+	selectFields := [][]string{}
+	for _, selectField := range n.SelectFields {
+		switch f := selectField.(type) {
+		case *ast.SelectField:
+			field := b.createSelectField(f, tmpTableMap)
+			selectFields = append(selectFields, field)
+			if containsField(rolledUp, f) {
+				columns = append(columns, "NULL")
+				continue
+			}
+			columns = append(columns, fmt.Sprintf("%s.%s", field[0], b.quote(field[1])))
+		case *ast.SoqlFunction:
+			name := strings.ToUpper(f.Name)
+			field := []string{"", "", name}
+			if len(f.Fields) > 0 {
+				field = append(b.createSelectField(f.Fields[0].(*ast.SelectField), tmpTableMap), name)
+			}
+			selectFields = append(selectFields, field)
+			if name != "GROUPING" {
+				columns = append(columns, b.createField(f, tmpTableMap))
+			} else if len(f.Fields) == 1 && containsField(rolledUp, f.Fields[0]) {
+				columns = append(columns, "1")
+			} else {
+				columns = append(columns, "0")
 			}
-			tmpTable := tmpTableMap[relationshipName]
-			// TODO: recursive relation
-			selectFields[i] = []string{tmpTable, v[1]}
 		}
+		// the subqueries of the child relationships are queried separately
 	}
+	return columns, selectFields
+}
 
-	tempFields := make([]string, len(selectFields))
-	for i, selectField := range selectFields {
-		tempFields[i] = strings.Join(selectField, ".")
+func (b *SqlBuilder) createSelectField(f *ast.SelectField, tmpTableMap map[string]string) []string {
+	v := f.Value
+	if len(v) == 1 {
+		return []string{"t0", v[0]}
 	}
-	return strings.Join(tempFields, ", "), selectFields
+	last := len(v) - 1
+	return []string{tmpTable(v[:last], tmpTableMap), v[last]}
 }
 
-func createRelations(from string, tmpTableMap map[string]string) map[string]Relation {
+// createRelations resolves the relationship paths of the joined tables from the sObject of FROM
+func createRelations(from string, tmpTableMap map[string]string) (map[string]Relation, error) {
 	relations := map[string]Relation{}
-	sObject := sObjects[from]
-	for relationshipName, tmpTableName := range tmpTableMap {
-		var targetField SobjectField
-		for _, sObjectField := range sObject.Fields {
-			if sObjectField.RelationshipName == relationshipName {
-				targetField = sObjectField
-				break
+	for path, tmpTableName := range tmpTableMap {
+		sObjectType := from
+		parent := "t0"
+		var relation Relation
+		names := strings.Split(path, ".")
+		for i, relationshipName := range names {
+			if i > 0 {
+				sObjectType = relation.ReferenceTo
+				parent = tmpTableMap[strings.Join(names[:i], ".")]
+			}
+			field, ok := findRelationshipField(sObjectType, relationshipName)
+			if !ok {
+				return nil, fmt.Errorf("Didn't understand relationship '%s' in field path.", relationshipName)
+			}
+			relation = Relation{
+				RelationshipName: field.RelationshipName,
+				FieldName:        field.Name,
+				ReferenceTo:      field.ReferenceTo[0],
+				Parent:           parent,
 			}
 		}
-		relations[tmpTableName] = Relation{
-			RelationshipName: relationshipName,
-			FieldName:        targetField.Name,
-			ReferenceTo:      targetField.ReferenceTo[0],
+		relations[tmpTableName] = relation
+	}
+	return relations, nil
+}
+
+// findRelationshipField returns the lookup field of the relationship name, which is case insensitive
+func findRelationshipField(sObjectType, relationshipName string) (SobjectField, bool) {
+	sObject, _ := findSObject(sObjectType)
+	for _, field := range sObject.Fields {
+		if strings.EqualFold(field.RelationshipName, relationshipName) && len(field.ReferenceTo) > 0 {
+			// TODO: polymorphic relation
+			return field, true
 		}
 	}
-	return relations
+	return SobjectField{}, false
 }
 
-func createLeftJoins(relations map[string]Relation) string {
+func (b *SqlBuilder) createLeftJoins(relations map[string]Relation) string {
+	// the parent tables are joined first, as their aliases are numbered in order of appearance
+	tmpTables := make([]string, 0, len(relations))
+	for tmpTable := range relations {
+		tmpTables = append(tmpTables, tmpTable)
+	}
+	sort.Slice(tmpTables, func(i, j int) bool {
+		return len(tmpTables[i]) < len(tmpTables[j]) ||
+			len(tmpTables[i]) == len(tmpTables[j]) && tmpTables[i] < tmpTables[j]
+	})
 	leftJoins := []string{}
-	for tmpTable, relation := range relations {
+	for _, tmpTable := range tmpTables {
+		relation := relations[tmpTable]
 		leftJoins = append(
 			leftJoins,
 			fmt.Sprintf(
 				"LEFT JOIN %s %s ON %s.%s = %s.id",
-				relation.ReferenceTo,
+				b.quote(relation.ReferenceTo),
 				tmpTable,
-				"t0", // TODO: recursive relation
-				relation.FieldName,
+				relation.Parent,
+				b.quote(relation.FieldName),
 				tmpTable,
 			),
 		)
@@ -0,0 +1,303 @@
+package builtin
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// literalEvaluator evaluates the literals and the bind variables of the conditions,
+// the bind variable :size is 5 and the others are the list of names
+type literalEvaluator struct {
+	ast.Visitor
+}
+
+func (v *literalEvaluator) VisitStringLiteral(n *ast.StringLiteral) (interface{}, error) {
+	return NewString(n.Value), nil
+}
+
+func (v *literalEvaluator) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
+	return NewInteger(n.Value), nil
+}
+
+func (v *literalEvaluator) VisitNullLiteral(n *ast.NullLiteral) (interface{}, error) {
+	return Null, nil
+}
+
+func (v *literalEvaluator) VisitSoqlBindVariable(n *ast.SoqlBindVariable) (interface{}, error) {
+	if name, ok := n.Expression.(*ast.Name); ok && name.Value[0] == "size" {
+		return NewInteger(5), nil
+	}
+	return CreateListObject(StringType, []*ast.Object{NewString("O'Brien"), NewString("Acme")}), nil
+}
+
+func TestSqlBuilderBuild(t *testing.T) {
+	condition := func(field, op string, exp ast.Node) *ast.WhereCondition {
+		return &ast.WhereCondition{Field: &ast.SelectField{Value: []string{field}}, Op: op, Expression: exp}
+	}
+	testCases := []struct {
+		Where ast.Node
+		Query string
+		Args  []interface{}
+	}{
+		{
+			condition("Name", "=", &ast.StringLiteral{Value: "O'Brien"}),
+			`SELECT t0."name" FROM "account" t0 WHERE t0."name" = ?`,
+			[]interface{}{"O'Brien"},
+		},
+		{
+			condition("Name", "IN", &ast.SoqlBindVariable{Expression: &ast.Name{Value: []string{"names"}}}),
+			`SELECT t0."name" FROM "account" t0 WHERE t0."name" IN (?, ?)`,
+			[]interface{}{"O'Brien", "Acme"},
+		},
+		{
+			&ast.WhereBinaryOperator{
+				Left: condition("Name", "=", &ast.NullLiteral{}),
+				Right: &ast.WhereBinaryOperator{
+					Left:  condition("NumberOfEmployees", ">", &ast.IntegerLiteral{Value: 10}),
+					Right: &ast.WhereCondition{Field: &ast.SelectField{Value: []string{"Name"}}, Op: "LIKE", Expression: &ast.StringLiteral{Value: "%'%"}, Not: true},
+					Op:    "OR",
+				},
+				Op: "AND",
+			},
+			`SELECT t0."name" FROM "account" t0 WHERE (t0."name" IS NULL AND (t0."numberofemployees" > ? OR NOT (t0."name" LIKE ?)))`,
+			[]interface{}{"10", "%'%"},
+		},
+	}
+	for _, testCase := range testCases {
+		builder := SqlBuilder{interpreter: &literalEvaluator{}, dialect: postgresDialect{}}
+		query, args, _, _, _ := builder.Build(&ast.Soql{
+			SelectFields: []ast.Node{&ast.SelectField{Value: []string{"Name"}}},
+			FromObject:   "Account",
+			Where:        testCase.Where,
+		})
+		if query != testCase.Query {
+			t.Errorf("expected %s, actual %s", testCase.Query, query)
+		}
+		if !reflect.DeepEqual(args, testCase.Args) {
+			t.Errorf("%s: expected %v, actual %v", testCase.Query, testCase.Args, args)
+		}
+	}
+}
+
+func TestSqlBuilderBuildClauses(t *testing.T) {
+	field := func(path ...string) *ast.SelectField {
+		return &ast.SelectField{Value: path}
+	}
+	testCases := []struct {
+		Soql    *ast.Soql
+		Dialect dialect
+		Query   string
+		Args    []interface{}
+	}{
+		// the nulls are first in ascending order and last in descending order by default
+		{
+			&ast.Soql{
+				Order: []*ast.Order{
+					{Field: field("Name"), Asc: true},
+					{Field: field("NumberOfEmployees"), Asc: false},
+				},
+			},
+			postgresDialect{},
+			`SELECT t0."name" FROM "account" t0 ORDER BY t0."name" IS NULL DESC, t0."name" ASC, t0."numberofemployees" IS NULL ASC, t0."numberofemployees" DESC`,
+			[]interface{}{},
+		},
+		{
+			&ast.Soql{
+				Order: []*ast.Order{
+					{Field: field("Name"), Asc: true, Nulls: "LAST"},
+					{Field: field("NumberOfEmployees"), Asc: false, Nulls: "FIRST"},
+				},
+			},
+			postgresDialect{},
+			`SELECT t0."name" FROM "account" t0 ORDER BY t0."name" IS NULL ASC, t0."name" ASC, t0."numberofemployees" IS NULL DESC, t0."numberofemployees" DESC`,
+			[]interface{}{},
+		},
+		{
+			&ast.Soql{
+				Where:  &ast.WhereCondition{Field: field("Name"), Op: "=", Expression: &ast.StringLiteral{Value: "foo"}},
+				Order:  []*ast.Order{{Field: field("Name"), Asc: true}},
+				Limit:  &ast.SoqlBindVariable{Expression: &ast.Name{Value: []string{"size"}}},
+				Offset: &ast.IntegerLiteral{Value: 10},
+			},
+			postgresDialect{},
+			`SELECT t0."name" FROM "account" t0 WHERE t0."name" = ? ORDER BY t0."name" IS NULL DESC, t0."name" ASC LIMIT ? OFFSET ?`,
+			[]interface{}{"foo", 5, 10},
+		},
+		{
+			&ast.Soql{
+				Offset: &ast.IntegerLiteral{Value: 10},
+			},
+			postgresDialect{},
+			`SELECT t0."name" FROM "account" t0 OFFSET ?`,
+			[]interface{}{10},
+		},
+		// SQLite does not allow OFFSET without LIMIT
+		{
+			&ast.Soql{
+				Offset: &ast.IntegerLiteral{Value: 10},
+			},
+			sqliteDialect{},
+			"SELECT t0.`Name` FROM `Account` t0 LIMIT -1 OFFSET ?",
+			[]interface{}{10},
+		},
+		{
+			&ast.Soql{
+				Limit: &ast.IntegerLiteral{Value: 3},
+			},
+			sqliteDialect{},
+			"SELECT t0.`Name` FROM `Account` t0 LIMIT ?",
+			[]interface{}{3},
+		},
+		// HAVING follows GROUP BY and WHERE is kept
+		{
+			&ast.Soql{
+				Where: &ast.WhereCondition{Field: field("Type"), Op: "!=", Expression: &ast.NullLiteral{}},
+				Group: &ast.Group{
+					Fields: []ast.Node{field("Name")},
+					Having: &ast.WhereCondition{
+						Field:      &ast.SoqlFunction{Name: "count", Fields: []ast.Node{field("Id")}},
+						Op:         ">",
+						Expression: &ast.IntegerLiteral{Value: 1},
+					},
+				},
+				Order: []*ast.Order{{Field: &ast.SoqlFunction{Name: "count"}, Asc: false}},
+				Limit: &ast.IntegerLiteral{Value: 3},
+			},
+			postgresDialect{},
+			`SELECT t0."name" FROM "account" t0 WHERE t0."type" IS NOT NULL GROUP BY t0."name" HAVING COUNT(t0."id") > ? ORDER BY COUNT(*) IS NULL ASC, COUNT(*) DESC LIMIT ?`,
+			[]interface{}{1, 3},
+		},
+		// ROLLUP is the union of the grouping sets, in which the rolled up fields are null
+		{
+			&ast.Soql{
+				Group: &ast.Group{
+					Fields: []ast.Node{&ast.SoqlFunction{Name: "ROLLUP", Fields: []ast.Node{field("Name")}}},
+					Having: &ast.WhereCondition{
+						Field:      &ast.SoqlFunction{Name: "count", Fields: []ast.Node{field("Id")}},
+						Op:         ">",
+						Expression: &ast.IntegerLiteral{Value: 1},
+					},
+				},
+				Order: []*ast.Order{{Field: field("Name"), Asc: true}},
+			},
+			postgresDialect{},
+			`SELECT * FROM (SELECT t0."name" AS c0 FROM "account" t0 GROUP BY t0."name" HAVING COUNT(t0."id") > ? UNION ALL SELECT g.c0 FROM (SELECT NULL AS c0, (COUNT(t0."id") > ?) AS h FROM "account" t0) g WHERE g.h) u ORDER BY u.c0 IS NULL DESC, u.c0 ASC`,
+			[]interface{}{1, 1},
+		},
+		// the arguments of HAVING in the grand total are bound before those of WHERE
+		{
+			&ast.Soql{
+				Where: &ast.WhereCondition{Field: field("Type"), Op: "!=", Expression: &ast.StringLiteral{Value: "zzz"}},
+				Group: &ast.Group{
+					Fields: []ast.Node{&ast.SoqlFunction{Name: "ROLLUP", Fields: []ast.Node{field("Name")}}},
+					Having: &ast.WhereCondition{
+						Field:      &ast.SoqlFunction{Name: "count", Fields: []ast.Node{field("Id")}},
+						Op:         ">",
+						Expression: &ast.IntegerLiteral{Value: 1},
+					},
+				},
+			},
+			postgresDialect{},
+			`SELECT * FROM (SELECT t0."name" AS c0 FROM "account" t0 WHERE t0."type" != ? GROUP BY t0."name" HAVING COUNT(t0."id") > ? UNION ALL SELECT g.c0 FROM (SELECT NULL AS c0, (COUNT(t0."id") > ?) AS h FROM "account" t0 WHERE t0."type" != ?) g WHERE g.h) u`,
+			[]interface{}{"zzz", 1, 1, "zzz"},
+		},
+	}
+	for _, testCase := range testCases {
+		builder := SqlBuilder{interpreter: &literalEvaluator{}, dialect: testCase.Dialect}
+		testCase.Soql.SelectFields = []ast.Node{field("Name")}
+		testCase.Soql.FromObject = "Account"
+		query, args, _, _, _ := builder.Build(testCase.Soql)
+		if query != testCase.Query {
+			t.Errorf("expected %s, actual %s", testCase.Query, query)
+		}
+		if !reflect.DeepEqual(args, testCase.Args) {
+			t.Errorf("%s: expected %v, actual %v", testCase.Query, testCase.Args, args)
+		}
+	}
+}
+
+func TestSqlBuilderBuildGroupingSetsOrder(t *testing.T) {
+	field := func(path ...string) *ast.SelectField {
+		return &ast.SelectField{Value: path}
+	}
+	builder := SqlBuilder{interpreter: &literalEvaluator{}, dialect: sqliteDialect{}}
+	// the subtotals of ROLLUP are sorted only by the select fields
+	_, _, _, _, err := builder.Build(&ast.Soql{
+		SelectFields: []ast.Node{field("Name"), &ast.SoqlFunction{Name: "COUNT", Fields: []ast.Node{field("Id")}}},
+		FromObject:   "Account",
+		Group:        &ast.Group{Fields: []ast.Node{&ast.SoqlFunction{Name: "ROLLUP", Fields: []ast.Node{field("Name")}}}},
+		Order:        []*ast.Order{{Field: field("Type"), Asc: true}},
+	})
+	expected := "ORDER BY field must be selected with ROLLUP or CUBE: Type"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected %s, actual %v", expected, err)
+	}
+}
+
+func TestSqlBuilderBuildRelations(t *testing.T) {
+	prev := sObjects
+	sObjects = map[string]Sobject{
+		"Account": {
+			Name: "Account",
+			Fields: []SobjectField{
+				{Name: "Name", Type: "string"},
+				{Name: "OwnerId", Type: "reference", RelationshipName: "Owner", ReferenceTo: []string{"User"}},
+			},
+		},
+		"Contact": {
+			Name: "Contact",
+			Fields: []SobjectField{
+				{Name: "LastName", Type: "string"},
+				{Name: "AccountId", Type: "reference", RelationshipName: "Account", ReferenceTo: []string{"Account"}},
+				{Name: "ReportsToId", Type: "reference", RelationshipName: "ReportsTo", ReferenceTo: []string{"Contact"}},
+			},
+		},
+		"User": {
+			Name: "User",
+			Fields: []SobjectField{
+				{Name: "Name", Type: "string"},
+				{Name: "ManagerId", Type: "reference", RelationshipName: "Manager", ReferenceTo: []string{"User"}},
+			},
+		},
+	}
+	defer func() {
+		sObjects = prev
+	}()
+	field := func(path ...string) *ast.SelectField {
+		return &ast.SelectField{Value: path}
+	}
+	builder := SqlBuilder{interpreter: &literalEvaluator{}, dialect: postgresDialect{}}
+	// the parent tables are joined on their parents, and the subqueries are not selected
+	query, args, selectFields, relations, _ := builder.Build(&ast.Soql{
+		SelectFields: []ast.Node{
+			field("LastName"),
+			field("Account", "Owner", "Manager", "Name"),
+			field("reportsto", "Name"),
+			&ast.Soql{SelectFields: []ast.Node{field("Id")}, FromObject: "Cases"},
+		},
+		FromObject: "Contact",
+		Where:      &ast.WhereCondition{Field: field("account", "Name"), Op: "=", Expression: &ast.StringLiteral{Value: "Acme"}},
+	})
+	expected := `SELECT t0."lastname", t3."name", t4."name" FROM "contact" t0` +
+		` LEFT JOIN "account" t1 ON t0."accountid" = t1.id` +
+		` LEFT JOIN "user" t2 ON t1."ownerid" = t2.id` +
+		` LEFT JOIN "user" t3 ON t2."managerid" = t3.id` +
+		` LEFT JOIN "contact" t4 ON t0."reportstoid" = t4.id` +
+		` WHERE t1."name" = ?`
+	if query != expected {
+		t.Errorf("expected %s, actual %s", expected, query)
+	}
+	if !reflect.DeepEqual(args, []interface{}{"Acme"}) {
+		t.Errorf("expected [Acme], actual %v", args)
+	}
+	expectedFields := [][]string{{"t0", "LastName"}, {"t3", "Name"}, {"t4", "Name"}}
+	if !reflect.DeepEqual(selectFields, expectedFields) {
+		t.Errorf("expected %v, actual %v", expectedFields, selectFields)
+	}
+	expectedRelation := Relation{RelationshipName: "Manager", FieldName: "ManagerId", ReferenceTo: "User", Parent: "t2"}
+	if relations["t3"] != expectedRelation {
+		t.Errorf("expected %v, actual %v", expectedRelation, relations["t3"])
+	}
+}
@@ -0,0 +1,23 @@
+package builtin
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// staticResources maps the names of static resources to the paths of the resource files
+var staticResources = map[string]string{}
+
+func SetStaticResource(name, path string) {
+	staticResources[strings.ToLower(name)] = path
+}
+
+// ReadStaticResource returns the content of the static resource
+func ReadStaticResource(name string) ([]byte, error) {
+	path, ok := staticResources[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("Static resource not found: %s", name)
+	}
+	return ioutil.ReadFile(path)
+}
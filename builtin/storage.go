@@ -0,0 +1,185 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// Storage is the database backend of the SObject records.
+// The queries are executed in one session, so that the transactions and the savepoints apply to all of them.
+type Storage interface {
+	Query(n *ast.Soql, interpreter ast.Visitor) ([]*ast.Object, error)
+	Execute(dmlType string, sObjectType string, records []*ast.Object, upsertKey string) (*ast.Object, error)
+	FindRecords(sObjectType string, field string, values []string) ([]*ast.Object, error)
+	CreateTable(name string, sobject Sobject) error
+
+	// Insert, Update and Delete save the record of the field values without triggers,
+	// which are used for the standard sObjects provided by land such as AsyncApexJob
+	Insert(sObjectType string, values map[string]interface{}) error
+	Update(sObjectType string, id string, values map[string]interface{}) error
+	Delete(sObjectType string, id string) error
+
+	// ExecuteRaw executes the SQL, which is not supported by the in-memory storage
+	ExecuteRaw(query string, args ...interface{}) error
+	// Quote quotes the table or column name in the raw SQL
+	Quote(identifier string) string
+
+	Begin() error
+	Commit() error
+	Rollback() error
+	// Isolate hides the records saved before in the transaction, such as the test without SeeAllData=true.
+	// The records saved after Isolate are discarded on Rollback.
+	Isolate() error
+	Savepoint(name string) error
+	RollbackToSavepoint(name string) error
+	ReleaseSavepoint(name string) error
+	Close() error
+}
+
+// DatabaseDriver is the storage selected by OpenDatabase, the SQLite file by default
+var DatabaseDriver, _ = newStorage(DefaultDatabaseURL)
+
+// OpenDatabase replaces the storage with the database of the URL, which is one of
+//
+//	sqlite3://{path}       SQLite file such as sqlite3://./database.sqlite3
+//	sqlite3://:memory:     SQLite in-memory database, which is discarded on exit
+//	memory://              in-memory storage without SQL, which does not require cgo
+//	postgres://{user}:{password}@{host}/{dbname}?sslmode=disable
+func OpenDatabase(url string) error {
+	storage, err := newStorage(url)
+	if err != nil {
+		return err
+	}
+	if DatabaseDriver != nil {
+		DatabaseDriver.Close()
+	}
+	DatabaseDriver = storage
+	return nil
+}
+
+// IsMemoryDatabase returns whether the database of the URL is discarded on exit
+func IsMemoryDatabase(url string) bool {
+	return strings.HasPrefix(url, "memory://") || strings.Contains(url, ":memory:") || strings.Contains(url, "mode=memory")
+}
+
+// loadedSObjects returns the sObjects of the metafile and the standard sObjects of land
+func loadedSObjects() map[string]Sobject {
+	sobjects := map[string]Sobject{}
+	for name, sobject := range standardSObjects {
+		sobjects[name] = sobject
+	}
+	for name, sobject := range sObjects {
+		sobjects[name] = sobject
+	}
+	return sobjects
+}
+
+// newSaveResult creates the result of the record saved successfully
+func newSaveResult(dmlType string, record *ast.Object) *ast.Object {
+	id, _ := record.InstanceFields.Get("Id")
+	return NewDmlResult(dmlType, id, dmlType == "insert", nil)
+}
+
+func newStorage(url string) (Storage, error) {
+	if url == "" {
+		url = DefaultDatabaseURL
+	}
+	switch {
+	case strings.HasPrefix(url, "memory://"):
+		return newMemoryStorage(), nil
+	case strings.HasPrefix(url, "sqlite3://"), strings.HasPrefix(url, "sqlite://"):
+		if !sqliteAvailable {
+			return nil, fmt.Errorf("SQLite is not available as land is built without cgo: %s", url)
+		}
+	}
+	switch {
+	case strings.HasPrefix(url, "sqlite3://"):
+		return newSqlStorage(sqliteDialect{}, strings.TrimPrefix(url, "sqlite3://")), nil
+	case strings.HasPrefix(url, "sqlite://"):
+		return newSqlStorage(sqliteDialect{}, strings.TrimPrefix(url, "sqlite://")), nil
+	case strings.HasPrefix(url, "postgres://"), strings.HasPrefix(url, "postgresql://"):
+		return newSqlStorage(postgresDialect{}, url), nil
+	}
+	return nil, fmt.Errorf("unsupported database URL: %s", url)
+}
+
+// dialect absorbs the differences of SQL between the database backends
+type dialect interface {
+	DriverName() string
+	// Quote quotes the table or column name, which may be a reserved word such as Case and Order
+	Quote(identifier string) string
+	// Rebind replaces the ? placeholders with the ones of the backend
+	Rebind(query string) string
+	// Limit returns the LIMIT and OFFSET clauses of the placeholders, either of which may be empty
+	Limit(limit, offset string) string
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) DriverName() string {
+	return "sqlite3"
+}
+
+func (sqliteDialect) Quote(identifier string) string {
+	return "`" + identifier + "`"
+}
+
+func (sqliteDialect) Rebind(query string) string {
+	return query
+}
+
+// Limit returns LIMIT -1 for OFFSET without LIMIT, as SQLite does not allow OFFSET alone
+func (sqliteDialect) Limit(limit, offset string) string {
+	if offset == "" {
+		if limit == "" {
+			return ""
+		}
+		return " LIMIT " + limit
+	}
+	if limit == "" {
+		limit = "-1"
+	}
+	return " LIMIT " + limit + " OFFSET " + offset
+}
+
+// postgresDialect folds the identifiers to lower case, as SOQL is case insensitive but quoted names in PostgreSQL are not
+type postgresDialect struct{}
+
+func (postgresDialect) DriverName() string {
+	return "postgres"
+}
+
+func (postgresDialect) Quote(identifier string) string {
+	return `"` + strings.ToLower(identifier) + `"`
+}
+
+func (postgresDialect) Rebind(query string) string {
+	var b strings.Builder
+	n := 0
+	quoted := false
+	for _, c := range query {
+		switch {
+		case c == '\'':
+			quoted = !quoted
+		case c == '?' && !quoted:
+			n++
+			fmt.Fprintf(&b, "$%d", n)
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+func (postgresDialect) Limit(limit, offset string) string {
+	clause := ""
+	if limit != "" {
+		clause += " LIMIT " + limit
+	}
+	if offset != "" {
+		clause += " OFFSET " + offset
+	}
+	return clause
+}
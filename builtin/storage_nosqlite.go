@@ -0,0 +1,8 @@
+//go:build !cgo
+
+package builtin
+
+// DefaultDatabaseURL is the in-memory storage, as go-sqlite3 requires cgo
+const DefaultDatabaseURL = "memory://"
+
+const sqliteAvailable = false
@@ -0,0 +1,12 @@
+//go:build cgo
+
+package builtin
+
+import (
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// DefaultDatabaseURL is the SQLite file in the working directory, which is used without --database
+const DefaultDatabaseURL = "sqlite3://./database.sqlite3"
+
+const sqliteAvailable = true
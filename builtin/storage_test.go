@@ -0,0 +1,217 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+func TestPostgresDialectRebind(t *testing.T) {
+	testCases := []struct {
+		Query    string
+		Expected string
+	}{
+		{
+			"SELECT id FROM account WHERE name = ?",
+			"SELECT id FROM account WHERE name = $1",
+		},
+		{
+			"UPDATE account SET name = ?, phone = ? WHERE id = ?",
+			"UPDATE account SET name = $1, phone = $2 WHERE id = $3",
+		},
+		{
+			"SELECT id FROM account WHERE name = 'what?' AND id = ?",
+			"SELECT id FROM account WHERE name = 'what?' AND id = $1",
+		},
+	}
+	for _, testCase := range testCases {
+		actual := postgresDialect{}.Rebind(testCase.Query)
+		if actual != testCase.Expected {
+			t.Errorf("%s: expected %s, actual %s", testCase.Query, testCase.Expected, actual)
+		}
+	}
+}
+
+func TestNewStorage(t *testing.T) {
+	if !sqliteAvailable {
+		t.Skip("SQLite requires cgo")
+	}
+	testCases := []struct {
+		URL        string
+		DriverName string
+		DSN        string
+	}{
+		{"", "sqlite3", "./database.sqlite3"},
+		{"sqlite3://./test.sqlite3", "sqlite3", "./test.sqlite3"},
+		{"sqlite3://:memory:", "sqlite3", ":memory:"},
+		{"postgres://land@localhost/land", "postgres", "postgres://land@localhost/land"},
+	}
+	for _, testCase := range testCases {
+		storage, err := newStorage(testCase.URL)
+		if err != nil {
+			t.Fatalf("%s: %s", testCase.URL, err.Error())
+		}
+		s := storage.(*sqlStorage)
+		if s.dialect.DriverName() != testCase.DriverName || s.dsn != testCase.DSN {
+			t.Errorf("%s: expected %s %s, actual %s %s", testCase.URL, testCase.DriverName, testCase.DSN, s.dialect.DriverName(), s.dsn)
+		}
+	}
+	if storage, err := newStorage("memory://"); err != nil {
+		t.Fatal(err)
+	} else if _, ok := storage.(*memoryStorage); !ok {
+		t.Errorf("memory://: expected the in-memory storage")
+	}
+	if _, err := newStorage("mysql://localhost/land"); err == nil {
+		t.Errorf("expected error for unsupported database")
+	}
+}
+
+func TestSqlStorageErrors(t *testing.T) {
+	query := &ast.Soql{
+		FromObject:   "Account",
+		SelectFields: []ast.Node{&ast.SelectField{Value: []string{"Name"}}},
+	}
+	unreachable := newSqlStorage(postgresDialect{}, "postgres://land@127.0.0.1:1/land?sslmode=disable")
+	if err := unreachable.Begin(); err == nil {
+		t.Errorf("expected error for unreachable database on Begin")
+	}
+	if _, err := unreachable.Query(query, &literalEvaluator{}); err == nil {
+		t.Errorf("expected error for unreachable database on Query")
+	}
+	if !sqliteAvailable {
+		return
+	}
+	empty := newSqlStorage(sqliteDialect{}, ":memory:")
+	defer empty.Close()
+	if _, err := empty.Query(query, &literalEvaluator{}); err == nil {
+		t.Errorf("expected error for query of missing table")
+	}
+}
+
+func TestStorageSavepoint(t *testing.T) {
+	storages := map[string]Storage{
+		"memory": newMemoryStorage(),
+	}
+	if sqliteAvailable {
+		storages["sqlite3"] = newSqlStorage(sqliteDialect{}, ":memory:")
+	}
+	sobject := Sobject{
+		Name: "Account",
+		Fields: []SobjectField{
+			{Name: "Id", Type: "id"},
+			{Name: "Name", Type: "string"},
+		},
+	}
+	for name, storage := range storages {
+		insert := func(id string) error {
+			return storage.Insert("Account", map[string]interface{}{"Id": id, "Name": "name"})
+		}
+		steps := []func() error{
+			func() error { return storage.CreateTable(sobject.Name, sobject) },
+			storage.Begin,
+			func() error { return insert("001000000000001") },
+			func() error { return storage.Savepoint("sp1") },
+			func() error { return insert("001000000000002") },
+			func() error { return storage.RollbackToSavepoint("sp1") },
+			func() error { return storage.ReleaseSavepoint("sp1") },
+		}
+		for _, step := range steps {
+			if err := step(); err != nil {
+				t.Fatalf("%s: %s", name, err.Error())
+			}
+		}
+		records, err := storage.FindRecords("Account", "Name", []string{"name"})
+		if err != nil {
+			t.Fatalf("%s: %s", name, err.Error())
+		}
+		if len(records) != 1 {
+			t.Errorf("%s: expected 1 record after rollback to savepoint, actual %d", name, len(records))
+		}
+		if err := storage.Rollback(); err != nil {
+			t.Fatalf("%s: %s", name, err.Error())
+		}
+		records, err = storage.FindRecords("Account", "Name", []string{"name"})
+		if err != nil {
+			t.Fatalf("%s: %s", name, err.Error())
+		}
+		if len(records) != 0 {
+			t.Errorf("%s: expected no record after rollback, actual %d", name, len(records))
+		}
+		storage.Close()
+	}
+}
+
+func TestStorageIsolate(t *testing.T) {
+	storages := map[string]Storage{
+		"memory": newMemoryStorage(),
+	}
+	if sqliteAvailable {
+		storages["sqlite3"] = newSqlStorage(sqliteDialect{}, ":memory:")
+	}
+	sobject := Sobject{
+		Name: "Account",
+		Fields: []SobjectField{
+			{Name: "Id", Type: "id"},
+			{Name: "Name", Type: "string"},
+		},
+	}
+	prev := sObjects
+	sObjects = map[string]Sobject{sobject.Name: sobject}
+	defer func() {
+		sObjects = prev
+	}()
+	for name, storage := range storages {
+		count := func() int {
+			records, err := storage.FindRecords("Account", "Name", []string{"seeded", "in test"})
+			if err != nil {
+				t.Fatalf("%s: %s", name, err.Error())
+			}
+			return len(records)
+		}
+		steps := []func() error{
+			func() error { return storage.CreateTable(sobject.Name, sobject) },
+			func() error {
+				return storage.Insert("Account", map[string]interface{}{"Id": "001000000000001", "Name": "seeded"})
+			},
+			storage.Begin,
+			storage.Isolate,
+		}
+		for _, step := range steps {
+			if err := step(); err != nil {
+				t.Fatalf("%s: %s", name, err.Error())
+			}
+		}
+		if count() != 0 {
+			t.Errorf("%s: expected the seeded record to be hidden, actual %d records", name, count())
+		}
+		if err := storage.Insert("Account", map[string]interface{}{"Id": "001000000000002", "Name": "in test"}); err != nil {
+			t.Fatalf("%s: %s", name, err.Error())
+		}
+		if count() != 1 {
+			t.Errorf("%s: expected 1 record in test, actual %d", name, count())
+		}
+		if err := storage.Rollback(); err != nil {
+			t.Fatalf("%s: %s", name, err.Error())
+		}
+		if count() != 1 {
+			t.Errorf("%s: expected only the seeded record after rollback, actual %d", name, count())
+		}
+		storage.Close()
+	}
+}
+
+func TestSqlStorageMissingTable(t *testing.T) {
+	if !sqliteAvailable {
+		t.Skip("SQLite requires cgo")
+	}
+	storage := newSqlStorage(sqliteDialect{}, ":memory:")
+	defer storage.Close()
+	record := ast.CreateObject(sObjectClassType("Account"))
+	record.InstanceFields.Set("Name", NewString("name"))
+	if _, err := storage.Execute("insert", "Account", []*ast.Object{record}, ""); err == nil {
+		t.Error("expected the error of the insert without the table")
+	}
+	if _, err := storage.FindRecords("Account", "Id", []string{"001000000000001"}); err == nil {
+		t.Error("expected the error of the query without the table")
+	}
+}
@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"strings"
 
 	"regexp"
@@ -183,8 +184,12 @@ func createStringType(c *ast.ClassType) *ast.ClassType {
 			StringType,
 			[]*ast.Parameter{IntegerTypeParameter},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				value := this.StringValue()
 				startIndex := params[0].IntegerValue()
-				return NewString(this.StringValue()[startIndex:])
+				if startIndex < 0 || startIndex > len(value) {
+					return CreateRaise(NewException(StringExceptionType, fmt.Sprintf("Starting position out of bounds: %d", startIndex)))
+				}
+				return NewString(value[startIndex:])
 			},
 		),
 		ast.CreateMethod(
@@ -195,9 +200,16 @@ func createStringType(c *ast.ClassType) *ast.ClassType {
 				IntegerTypeParameter,
 			},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				value := this.StringValue()
 				startIndex := params[0].IntegerValue()
 				endIndex := params[1].IntegerValue()
-				return NewString(this.StringValue()[startIndex:endIndex])
+				if startIndex < 0 || startIndex > len(value) {
+					return CreateRaise(NewException(StringExceptionType, fmt.Sprintf("Starting position out of bounds: %d", startIndex)))
+				}
+				if endIndex < startIndex || endIndex > len(value) {
+					return CreateRaise(NewException(StringExceptionType, fmt.Sprintf("Ending position out of bounds: %d", endIndex)))
+				}
+				return NewString(value[startIndex:endIndex])
 			},
 		),
 	})
@@ -19,6 +19,11 @@ type EqualChecker interface {
 	Equals(*ast.Object, *ast.Object) bool
 }
 
+// MethodInvoker invokes the method of user class from native functions, which is implemented by interpreter
+type MethodInvoker interface {
+	InvokeMethod(receiver *ast.Object, methodName string, parameters []*ast.Object) (*ast.Object, error)
+}
+
 func init() {
 	system := ast.CreateClass(
 		"System",
@@ -71,7 +76,15 @@ func init() {
 		},
 	)
 
+	setAsyncMethods(system.StaticMethods)
+	setScheduleMethods(system.StaticMethods)
+
 	primitiveClassMap.Set("system", system)
+
+	primitiveClassMap.Set("AccessLevel", AccessLevelType)
+	nameSpaceStore.Add("System", AccessLevelType)
+	primitiveClassMap.Set("StatusCode", StatusCodeType)
+	nameSpaceStore.Add("System", StatusCodeType)
 }
 
 type TestError struct {
@@ -0,0 +1,67 @@
+package builtin
+
+import "github.com/tzmfreedom/land/ast"
+
+// TypeType is System.Type, the value of `Foo.class`
+var TypeType = ast.CreateClass(
+	"Type",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var typeTypeParameter = &ast.Parameter{
+	Type: TypeType,
+	Name: "_",
+}
+
+// NewTypeObject creates System.Type representing the class
+func NewTypeObject(classType *ast.ClassType) *ast.Object {
+	obj := ast.CreateObject(TypeType)
+	obj.Extra["type"] = classType
+	return obj
+}
+
+// TypeOf returns the class represented by System.Type
+func TypeOf(obj *ast.Object) *ast.ClassType {
+	return obj.Extra["type"].(*ast.ClassType)
+}
+
+func init() {
+	TypeType.ToString = func(o *ast.Object) string {
+		return TypeOf(o).String()
+	}
+	TypeType.InstanceMethods.Set(
+		"getName",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getName",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(TypeOf(this).String())
+				},
+			),
+		},
+	)
+	TypeType.InstanceMethods.Set(
+		"equals",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"equals",
+				BooleanType,
+				[]*ast.Parameter{objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					other := params[0]
+					if other.ClassType != TypeType {
+						return NewBoolean(false)
+					}
+					return NewBoolean(TypeOf(this) == TypeOf(other))
+				},
+			),
+		},
+	)
+
+	primitiveClassMap.Set("Type", TypeType)
+	nameSpaceStore.Add("System", TypeType)
+}
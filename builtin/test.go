@@ -1,6 +1,22 @@
 package builtin
 
-import "github.com/tzmfreedom/land/ast"
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// StandardPricebookId is the Id of the standard price book returned by Test.getStandardPricebookId
+const StandardPricebookId = "01s000000000000AAA"
+
+// TestContext controls the governor limits and the asynchronous jobs in test, which is implemented by interpreter
+type TestContext interface {
+	StartTest() error
+	StopTest() error
+	IsRunningTest() bool
+}
 
 var testType = createTestType()
 
@@ -21,6 +37,91 @@ func createTestType() *ast.ClassType {
 			),
 		},
 	)
+	staticMethods.Set(
+		"startTest",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"startTest",
+				nil,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return testContextResult(extra["interpreter"].(TestContext).StartTest())
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"stopTest",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"stopTest",
+				nil,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return testContextResult(extra["interpreter"].(TestContext).StopTest())
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"isRunningTest",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"isRunningTest",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(extra["interpreter"].(TestContext).IsRunningTest())
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"setMock",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setMock",
+				nil,
+				[]*ast.Parameter{typeTypeParameter, objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return setMock(TypeOf(params[0]), params[1], extra)
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"getStandardPricebookId",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getStandardPricebookId",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(StandardPricebookId)
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"setFixedSearchResults",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setFixedSearchResults",
+				nil,
+				[]*ast.Parameter{CreateListTypeParameter(StringType)},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					ids := []string{}
+					if params[0] != Null {
+						for _, id := range params[0].Extra["records"].([]*ast.Object) {
+							ids = append(ids, id.StringValue())
+						}
+					}
+					extra["fixed_search_results"] = ids
+					return nil
+				},
+			),
+		},
+	)
 
 	classType := ast.CreateClass(
 		"Test",
@@ -31,6 +132,73 @@ func createTestType() *ast.ClassType {
 	return classType
 }
 
+func testContextResult(err error) interface{} {
+	if err != nil {
+		if raise, ok := err.(*RaiseError); ok {
+			return CreateRaise(raise.Exception)
+		}
+		panic(err)
+	}
+	return nil
+}
+
+// loadData inserts the records in the csv static resource, whose header is the field names
+func loadData(sObjectType *ast.Object, resourceName string, extra map[string]interface{}) interface{} {
+	typeName := sObjectType.Extra["type"].(string)
+	classType, ok := PrimitiveClassMap().Get(typeName)
+	if !ok {
+		return CreateRaise(NewException(TypeExceptionType, fmt.Sprintf("Invalid SObject type: %s", typeName)))
+	}
+	content, err := ReadStaticResource(resourceName)
+	if err != nil {
+		return CreateRaise(NewException(ExceptionType, err.Error()))
+	}
+	rows, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	if err != nil {
+		return CreateRaise(NewException(ExceptionType, err.Error()))
+	}
+	records := []*ast.Object{}
+	if len(rows) == 0 {
+		return CreateListObject(nil, records)
+	}
+	header := rows[0]
+	for _, name := range header {
+		if _, ok := classType.InstanceFields.Get(name); !ok {
+			return CreateRaise(NewException(ExceptionType, fmt.Sprintf("Invalid field %s for %s", name, classType.Name)))
+		}
+	}
+	for _, row := range rows[1:] {
+		record := ast.CreateObject(classType)
+		for i, value := range row {
+			if value != "" {
+				record.InstanceFields.Set(header[i], newFieldValue(classType.Name, header[i], value))
+			}
+		}
+		records = append(records, record)
+	}
+	if result, ok := executeDml(extra, "insert", records, "", true).(*ast.Object); ok && result.ClassType == RaiseType {
+		return result
+	}
+	return CreateListObject(nil, records)
+}
+
 func init() {
+	// Schema.SObjectType is created in init of schema.go
+	testType.StaticMethods.Set(
+		"loadData",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"loadData",
+				CreateListType(SObjectType),
+				[]*ast.Parameter{
+					{Type: schemaSObjectType, Name: "_"},
+					stringTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return loadData(params[0], params[1].StringValue(), extra)
+				},
+			),
+		},
+	)
 	primitiveClassMap.Set("Test", testType)
 }
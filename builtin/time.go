@@ -24,6 +24,9 @@ var timeTypeParameter = &ast.Parameter{
 }
 
 func init() {
+	timeType.ToString = func(o *ast.Object) string {
+		return o.Value().(time.Time).Format(timeLayout) + "Z"
+	}
 	timeType.InstanceMethods.Set(
 		"addHours",
 		[]*ast.Method{
@@ -0,0 +1,131 @@
+package builtin
+
+import (
+	"github.com/tzmfreedom/land/ast"
+)
+
+var TriggerOperationType = CreateEnumType("TriggerOperation", []string{
+	"BEFORE_INSERT",
+	"BEFORE_UPDATE",
+	"BEFORE_DELETE",
+	"AFTER_INSERT",
+	"AFTER_UPDATE",
+	"AFTER_DELETE",
+	"AFTER_UNDELETE",
+})
+
+// TriggerType is Trigger referred from classes such as trigger handlers,
+// its records are typed as SObject
+var TriggerType = CreateTriggerContextType(SObjectType)
+
+// CreateTriggerContextType creates Trigger class whose records are typed as sObjectType.
+// Trigger is a variable of this type in the body of trigger,
+// so that Trigger.new is List<Account> in the trigger on Account.
+func CreateTriggerContextType(sObjectType *ast.ClassType) *ast.ClassType {
+	classType := ast.CreateClass(
+		"Trigger",
+		[]*ast.Method{},
+		ast.NewMethodMap(),
+		ast.NewMethodMap(),
+	)
+	listType := CreateListType(sObjectType)
+	mapType := CreateMapType(StringType, sObjectType)
+	classType.InstanceFields.Set("new", ast.CreateField("new", listType))
+	classType.InstanceFields.Set("old", ast.CreateField("old", listType))
+	classType.InstanceFields.Set("newMap", ast.CreateField("newMap", mapType))
+	classType.InstanceFields.Set("oldMap", ast.CreateField("oldMap", mapType))
+	for _, name := range []string{
+		"isExecuting",
+		"isInsert",
+		"isUpdate",
+		"isDelete",
+		"isUndelete",
+		"isBefore",
+		"isAfter",
+	} {
+		classType.InstanceFields.Set(name, ast.CreateField(name, BooleanType))
+	}
+	classType.InstanceFields.Set("size", ast.CreateField("size", IntegerType))
+	classType.InstanceFields.Set("operationType", ast.CreateField("operationType", TriggerOperationType))
+	return classType
+}
+
+// NewTriggerContext creates the value of Trigger while the trigger is fired by dml.
+// newRecords and oldRecords are nil if they are not available for the dml.
+func NewTriggerContext(contextType *ast.ClassType, timing, dml string, newRecords, oldRecords []*ast.Object) *ast.Object {
+	isBefore := timing == "before"
+	size := len(newRecords)
+	if newRecords == nil {
+		size = len(oldRecords)
+	}
+
+	context := ast.CreateObject(contextType)
+	context.InstanceFields.Set("isExecuting", NewBoolean(true))
+	context.InstanceFields.Set("isInsert", NewBoolean(dml == "insert"))
+	context.InstanceFields.Set("isUpdate", NewBoolean(dml == "update"))
+	context.InstanceFields.Set("isDelete", NewBoolean(dml == "delete"))
+	context.InstanceFields.Set("isUndelete", NewBoolean(dml == "undelete"))
+	context.InstanceFields.Set("isBefore", NewBoolean(isBefore))
+	context.InstanceFields.Set("isAfter", NewBoolean(!isBefore))
+	context.InstanceFields.Set("size", NewInteger(size))
+	context.InstanceFields.Set("operationType", EnumValueOf(TriggerOperationType, timing+"_"+dml))
+
+	newField, _ := contextType.InstanceFields.Get("new")
+	newMapField, _ := contextType.InstanceFields.Get("newMap")
+	context.InstanceFields.Set("new", Null)
+	context.InstanceFields.Set("old", Null)
+	context.InstanceFields.Set("newMap", Null)
+	context.InstanceFields.Set("oldMap", Null)
+	if newRecords != nil {
+		context.InstanceFields.Set("new", createTriggerRecords(newField.Type, newRecords))
+		// records have no Id before insert
+		if !(isBefore && dml == "insert") {
+			context.InstanceFields.Set("newMap", createTriggerRecordMap(newMapField.Type, newRecords))
+		}
+	}
+	if oldRecords != nil {
+		context.InstanceFields.Set("old", createTriggerRecords(newField.Type, oldRecords))
+		context.InstanceFields.Set("oldMap", createTriggerRecordMap(newMapField.Type, oldRecords))
+	}
+	return context
+}
+
+func createTriggerRecords(listType *ast.ClassType, records []*ast.Object) *ast.Object {
+	list := ast.CreateObject(listType)
+	list.Extra["records"] = records
+	return list
+}
+
+func createTriggerRecordMap(mapType *ast.ClassType, records []*ast.Object) *ast.Object {
+	values := map[string]*ast.Object{}
+	for _, record := range records {
+		if id, ok := record.InstanceFields.Get("Id"); ok && id != Null {
+			values[id.StringValue()] = record
+		}
+	}
+	recordMap := ast.CreateObject(mapType)
+	recordMap.Extra["values"] = values
+	return recordMap
+}
+
+func init() {
+	// Trigger outside of trigger is not executing
+	for _, f := range TriggerType.InstanceFields.Data {
+		field := ast.CreateField(f.Name, f.Type)
+		field.Modifiers = []*ast.Modifier{ast.PublicModifier(), {Name: "static"}}
+		switch f.Type {
+		case BooleanType:
+			field.Expression = &ast.BooleanLiteral{Value: false}
+		case IntegerType:
+			field.Expression = &ast.IntegerLiteral{Value: 0}
+		default:
+			field.Expression = &ast.NullLiteral{}
+		}
+		TriggerType.StaticFields.Set(f.Name, field)
+	}
+	TriggerType.InstanceFields = ast.NewFieldMap()
+
+	primitiveClassMap.Set("Trigger", TriggerType)
+	primitiveClassMap.Set("TriggerOperation", TriggerOperationType)
+	nameSpaceStore.Add("System", TriggerOperationType)
+}
@@ -65,7 +65,7 @@ func (m *NameSpaceStore) Add(k string, n *ast.ClassType) {
 	if !ok {
 		panic("NameSpaceStore#Add failed")
 	}
-	classMap.Set(k, n)
+	classMap.Set(n.Name, n)
 }
 
 func (m *NameSpaceStore) Set(k string, n *ast.ClassMap) {
@@ -77,6 +77,24 @@ func (m *NameSpaceStore) Get(k string) (*ast.ClassMap, bool) {
 	return n, ok
 }
 
+// Implements reports whether the class or its super classes implement the interface,
+// the interface with type parameters such as Database.Batchable<SObject> is also matched
+func Implements(classType, iface *ast.ClassType) bool {
+	return ImplementedType(classType, iface) != nil
+}
+
+// ImplementedType returns the interface implemented by the class with its type parameters, or nil
+func ImplementedType(classType, iface *ast.ClassType) *ast.ClassType {
+	for t := classType; t != nil; t = t.SuperClass {
+		for _, impl := range t.ImplementClasses {
+			if impl == iface || (impl.Name == iface.Name && impl.InstanceMethods == iface.InstanceMethods) {
+				return impl
+			}
+		}
+	}
+	return nil
+}
+
 func Equals(t, other *ast.ClassType) bool {
 	if other == NullType {
 		return true
@@ -84,7 +102,15 @@ func Equals(t, other *ast.ClassType) bool {
 	if t == ObjectType {
 		return true
 	}
+	// String is converted to Id implicitly, and vice versa
+	if (t == StringType && other == IdType) || (t == IdType && other == StringType) {
+		return true
+	}
 	if t.IsGenerics() && other.IsGenerics() {
+		// List and Set can be iterated in for loop and in batch
+		if t.Name == "Iterable" && (other.Name == "List" || other.Name == "Set") {
+			return len(t.Generics) == 1 && len(other.Generics) == 1 && Equals(t.Generics[0], other.Generics[0])
+		}
 		if t.Name != other.Name {
 			return false
 		}
@@ -94,6 +120,10 @@ func Equals(t, other *ast.ClassType) bool {
 			return false
 		}
 		for i, classType := range types {
+			// List<SObject> such as the result of Database.query is assigned to the list of the concrete sObject
+			if t.Name == "List" && otherTypes[i] == SObjectType && Equals(SObjectType, classType) {
+				continue
+			}
 			if !Equals(classType, otherTypes[i]) {
 				return false
 			}
@@ -117,6 +147,16 @@ func Equals(t, other *ast.ClassType) bool {
 			}
 		}
 	}
+	// the class implementing the interface with type parameters, such as Iterable<String>
+	if t.IsGenerics() && !other.IsGenerics() {
+		for c := other; c != nil; c = c.SuperClass {
+			for _, impl := range c.ImplementClasses {
+				if impl.IsGenerics() && Equals(t, impl) {
+					return true
+				}
+			}
+		}
+	}
 	return false
 }
 
@@ -78,6 +78,25 @@ var metaFileFlag = cli.StringFlag{
 	Value:  builtin.DefaultMetafileName,
 }
 
+var calloutConfigFlag = cli.StringFlag{
+	Name:   "callouts",
+	EnvVar: "LAND_CALLOUT_CONFIG",
+	Value:  builtin.DefaultCalloutConfigName,
+}
+
+var limitsConfigFlag = cli.StringFlag{
+	Name:   "limits",
+	EnvVar: "LAND_LIMITS_CONFIG",
+	Value:  builtin.DefaultLimitsConfigName,
+}
+
+var databaseFlag = cli.StringFlag{
+	Name:   "database",
+	EnvVar: "LAND_DATABASE_URL",
+	Value:  builtin.DefaultDatabaseURL,
+	Usage:  "sqlite3://{path}, sqlite3://:memory: or postgres://{user}:{password}@{host}/{dbname}",
+}
+
 var dbSetupCommand = cli.Command{
 	Name:  "db:setup",
 	Usage: "",
@@ -86,8 +105,12 @@ var dbSetupCommand = cli.Command{
 		passwordFlag,
 		endpointFlag,
 		metaFileFlag,
+		databaseFlag,
 	},
 	Action: func(c *cli.Context) error {
+		if err := builtin.OpenDatabase(c.String("database")); err != nil {
+			return err
+		}
 		username := c.String("username")
 		password := c.String("password")
 		endpoint := c.String("endpoint")
@@ -128,8 +151,12 @@ var dbCreateCommand = cli.Command{
 	Usage: "",
 	Flags: []cli.Flag{
 		metaFileFlag,
+		databaseFlag,
 	},
 	Action: func(c *cli.Context) error {
+		if err := builtin.OpenDatabase(c.String("database")); err != nil {
+			return err
+		}
 		metafile := c.String("metafile")
 		return builtin.CreateDatabase(metafile)
 	},
@@ -140,8 +167,12 @@ var dbSeedCommand = cli.Command{
 	Usage: "",
 	Flags: []cli.Flag{
 		metaFileFlag,
+		databaseFlag,
 	},
 	Action: func(c *cli.Context) error {
+		if err := builtin.OpenDatabase(c.String("database")); err != nil {
+			return err
+		}
 		username := prompter.Prompt("Salesforce username", "")
 		password := prompter.Password("Salesforce password")
 		endpoint := prompter.Prompt("Login Endpoint", "login.salesforce.com")
@@ -182,9 +213,21 @@ var testCommand = cli.Command{
 		fileFlag,
 		directoryFlag,
 		metaFileFlag,
+		calloutConfigFlag,
+		limitsConfigFlag,
+		databaseFlag,
 	},
 	Action: func(c *cli.Context) error {
 		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := openDatabase(c); err != nil {
+			return err
+		}
+		if err := builtin.LoadCalloutConfig(c.String("callouts")); err != nil {
+			return err
+		}
+		if err := builtin.LoadLimitsConfig(c.String("limits")); err != nil {
+			return err
+		}
 
 		files, err := parseFileOption(c)
 		if err != nil {
@@ -223,10 +266,14 @@ var watchCommand = cli.Command{
 			Value: "classes",
 		},
 		metaFileFlag,
+		databaseFlag,
 	},
 	Action: func(c *cli.Context) error {
 		directory := c.String("directory")
 		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := openDatabase(c); err != nil {
+			return err
+		}
 
 		files, err := parseFileOption(c)
 		if err != nil {
@@ -251,9 +298,13 @@ var serverCommand = cli.Command{
 		fileFlag,
 		directoryFlag,
 		metaFileFlag,
+		databaseFlag,
 	},
 	Action: func(c *cli.Context) error {
 		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := openDatabase(c); err != nil {
+			return err
+		}
 
 		files, err := parseFileOption(c)
 		if err != nil {
@@ -277,9 +328,13 @@ var evalServerCommand = cli.Command{
 	Usage: "",
 	Flags: []cli.Flag{
 		metaFileFlag,
+		databaseFlag,
 	},
 	Action: func(c *cli.Context) error {
 		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := openDatabase(c); err != nil {
+			return err
+		}
 
 		s := &server.EvalServer{}
 		s.Run()
@@ -323,12 +378,24 @@ var runCommand = cli.Command{
 		directoryFlag,
 		actionFlag,
 		metaFileFlag,
+		calloutConfigFlag,
+		limitsConfigFlag,
+		databaseFlag,
 	},
 	Action: func(c *cli.Context) error {
 		if c.String("action") == "" {
 			return errors.New("-a CLASS#METHOD is required")
 		}
 		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := openDatabase(c); err != nil {
+			return err
+		}
+		if err := builtin.LoadCalloutConfig(c.String("callouts")); err != nil {
+			return err
+		}
+		if err := builtin.LoadLimitsConfig(c.String("limits")); err != nil {
+			return err
+		}
 
 		files, err := parseFileOption(c)
 		if err != nil {
@@ -357,6 +424,78 @@ var runCommand = cli.Command{
 	},
 }
 
+var scheduleCommand = cli.Command{
+	Name:  "schedule",
+	Usage: "",
+	Flags: []cli.Flag{
+		fileFlag,
+		directoryFlag,
+		actionFlag,
+		interactiveFlag,
+		metaFileFlag,
+		calloutConfigFlag,
+		limitsConfigFlag,
+		databaseFlag,
+		cli.StringFlag{
+			Name:  "start",
+			Usage: "start time of the virtual clock such as '2026-01-01 00:00:00', defaults to now",
+		},
+		cli.StringFlag{
+			Name:  "until",
+			Usage: "end time of the virtual clock, defaults to the start time plus the duration",
+		},
+		cli.DurationFlag{
+			Name:  "duration",
+			Value: 24 * time.Hour,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		if c.String("action") == "" {
+			return errors.New("-a CLASS#METHOD is required")
+		}
+		start := time.Now()
+		if c.String("start") != "" {
+			t, err := time.ParseInLocation(builtin.CronTimeLayout, c.String("start"), time.Local)
+			if err != nil {
+				return err
+			}
+			start = t
+		}
+		until := start.Add(c.Duration("duration"))
+		if c.String("until") != "" {
+			t, err := time.ParseInLocation(builtin.CronTimeLayout, c.String("until"), time.Local)
+			if err != nil {
+				return err
+			}
+			until = t
+		}
+		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := openDatabase(c); err != nil {
+			return err
+		}
+		if err := builtin.LoadCalloutConfig(c.String("callouts")); err != nil {
+			return err
+		}
+		if err := builtin.LoadLimitsConfig(c.String("limits")); err != nil {
+			return err
+		}
+
+		files, err := parseFileOption(c)
+		if err != nil {
+			return err
+		}
+		trees, err := parseFiles(files)
+		if err != nil {
+			return err
+		}
+		classTypes, err := buildAllFile(trees)
+		if err != nil {
+			return err
+		}
+		return schedule(c.String("action"), classTypes, start, until, c.Bool("interactive"))
+	},
+}
+
 var checkCommand = cli.Command{
 	Name:  "check",
 	Usage: "",
@@ -397,9 +536,13 @@ var visualforceCommand = cli.Command{
 		fileFlag,
 		directoryFlag,
 		metaFileFlag,
+		databaseFlag,
 	},
 	Action: func(c *cli.Context) error {
 		builtin.LoadSObjectClass(c.String("metafile"))
+		if err := openDatabase(c); err != nil {
+			return err
+		}
 
 		files, err := parseFileOption(c)
 		if err != nil {
@@ -453,15 +596,39 @@ func parseFileOption(c *cli.Context) ([]string, error) {
 				continue
 			}
 			ext := filepath.Ext(f.Name())
-			if ext != ".cls" && ext != ".apxc" {
+			if ext != ".cls" && ext != ".apxc" && ext != ".trigger" {
 				continue
 			}
 			files = append(files, fmt.Sprintf("%s/%s", dir, f.Name()))
 		}
+		for _, resourceDir := range []string{dir, filepath.Join(dir, "staticresources")} {
+			if err := registerStaticResources(resourceDir); err != nil {
+				return nil, err
+			}
+		}
 	}
 	return files, nil
 }
 
+// registerStaticResources registers *.resource files in the directory as static resources
+func registerStaticResources(dir string) error {
+	filesInDirectory, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, f := range filesInDirectory {
+		ext := filepath.Ext(f.Name())
+		if f.IsDir() || ext != ".resource" {
+			continue
+		}
+		builtin.SetStaticResource(strings.TrimSuffix(f.Name(), ext), filepath.Join(dir, f.Name()))
+	}
+	return nil
+}
+
 func convert(n *ast.ClassType, classMap *ast.ClassMap) (*ast.ClassType, error) {
 	resolver := compiler.NewTypeRefResolver(classMap, builtin.GetNameSpaceStore())
 	return resolver.Resolve(n)
@@ -506,27 +673,152 @@ func semanticAnalysis(t *ast.ClassType) error {
 	return err
 }
 
-func run(action string, classTypes []*ast.ClassType, options ...func(*interpreter.Interpreter)) error {
+// openDatabase connects to the database of --database after the sObjects are loaded,
+// and creates their tables if the database is in-memory
+func openDatabase(c *cli.Context) error {
+	url := c.String("database")
+	if err := builtin.OpenDatabase(url); err != nil {
+		return err
+	}
+	if builtin.IsMemoryDatabase(url) {
+		return builtin.CreateTables()
+	}
+	return nil
+}
+
+// actionExpression returns the class and the method name of CLASS#METHOD, the method defaults to action
+func actionExpression(action string) []string {
 	method := "action"
 	args := strings.Split(action, "#")
 	if len(args) > 1 {
 		method = args[1]
 	}
+	return []string{args[0], method}
+}
+
+func run(action string, classTypes []*ast.ClassType, options ...func(*interpreter.Interpreter)) error {
 	interpreter := interpreter.NewInterpreterWithBuiltin(classTypes)
 	invoke := &ast.MethodInvocation{
 		NameOrExpression: &ast.Name{
-			Value: []string{args[0], method},
+			Value: actionExpression(action),
 		},
 	}
 	for _, option := range options {
 		option(interpreter)
 	}
-	builtin.DatabaseDriver.Begin()
+	if err := builtin.DatabaseDriver.Begin(); err != nil {
+		return err
+	}
 	defer builtin.DatabaseDriver.Rollback()
+	if interpreter.Context.IsRunningTest && !interpreter.Context.SeeAllData {
+		if err := builtin.DatabaseDriver.Isolate(); err != nil {
+			return err
+		}
+	}
 
 	interpreter.LoadStaticField()
-	_, err := invoke.Accept(interpreter)
-	return err
+	if _, err := invoke.Accept(interpreter); err != nil {
+		return err
+	}
+	return interpreter.RunAsyncJobs()
+}
+
+// schedule invokes the action which schedules the jobs, then fires the jobs in order of their fire times
+// with the virtual clock until the end time. In interactive mode, the clock is advanced by the commands.
+func schedule(action string, classTypes []*ast.ClassType, start, until time.Time, interactive bool) error {
+	clock := start
+	builtin.Now = func() time.Time {
+		return clock
+	}
+	defer func() {
+		builtin.Now = time.Now
+	}()
+
+	landInterpreter := interpreter.NewInterpreterWithBuiltin(classTypes)
+	if err := builtin.DatabaseDriver.Begin(); err != nil {
+		return err
+	}
+	defer builtin.DatabaseDriver.Rollback()
+
+	if err := runAction(landInterpreter, actionExpression(action)); err != nil {
+		return err
+	}
+	fire := func(job *interpreter.ScheduledJob) {
+		clock = job.Trigger.NextFireTime
+		fmt.Printf("[%s] %s (%s)\n", clock.Format(builtin.CronTimeLayout), job.Trigger.Name, job.Trigger.Id)
+		// each execution is a new transaction, which has the initial static fields
+		landInterpreter.LoadStaticField()
+		if err := landInterpreter.FireScheduledJob(job); err != nil {
+			fmt.Println(err.Error())
+		}
+	}
+	forward := func(until time.Time) {
+		for {
+			job := landInterpreter.NextScheduledJob()
+			if job == nil || job.Trigger.NextFireTime.After(until) {
+				break
+			}
+			fire(job)
+		}
+		clock = until
+	}
+	if !interactive {
+		forward(until)
+		return nil
+	}
+
+	l, err := readline.NewEx(&readline.Config{
+		Prompt:          "\033[31m>>\033[0m ",
+		InterruptPrompt: "^C",
+		EOFPrompt:       "exit",
+	})
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+	for {
+		line, err := l.Readline()
+		if err != nil {
+			return nil
+		}
+		inputs := strings.Fields(line)
+		if len(inputs) == 0 {
+			continue
+		}
+		switch inputs[0] {
+		case "now":
+			fmt.Println(clock.Format(builtin.CronTimeLayout))
+		case "jobs":
+			for _, job := range landInterpreter.Context.ScheduledJobs {
+				if job.Trigger.State != builtin.CronTriggerStateWaiting {
+					continue
+				}
+				fmt.Printf("%s %s '%s' next: %s\n", job.Trigger.Id, job.Trigger.Name, job.Trigger.CronExpression, job.Trigger.NextFireTime.Format(builtin.CronTimeLayout))
+			}
+		case "next":
+			job := landInterpreter.NextScheduledJob()
+			if job == nil {
+				fmt.Println("no scheduled jobs")
+				continue
+			}
+			fire(job)
+		case "forward":
+			if len(inputs) < 2 {
+				fmt.Println("Error: forward command required duration argument such as 1h30m")
+				continue
+			}
+			d, err := time.ParseDuration(inputs[1])
+			if err != nil {
+				fmt.Printf("Error: %s\n", err.Error())
+				continue
+			}
+			forward(clock.Add(d))
+		case "exit":
+			return nil
+		default:
+			fmt.Println("Error: commands are now, jobs, next, forward DURATION and exit")
+		}
+	}
 }
 
 func interactiveRun(classTypes []*ast.ClassType, files []string) error {
@@ -703,8 +995,10 @@ func runAction(interpreter *interpreter.Interpreter, expression []string) error
 		},
 	}
 	interpreter.LoadStaticField()
-	_, err := invoke.Accept(interpreter)
-	return err
+	if _, err := invoke.Accept(interpreter); err != nil {
+		return err
+	}
+	return interpreter.RunAsyncJobs()
 }
 
 func buildFile(interpreter *interpreter.Interpreter, file string) (*ast.ClassType, error) {
@@ -839,6 +1133,22 @@ func reloadAll(interpreter *interpreter.Interpreter, files []string) error {
 	return nil
 }
 
+// seeAllData returns whether the test can see the existing records,
+// by @isTest(SeeAllData=true) of the method or the class
+func seeAllData(classType *ast.ClassType, m *ast.Method) bool {
+	for _, annotation := range []*ast.Annotation{m.Annotation("isTest"), classType.Annotation("isTest")} {
+		if annotation == nil {
+			continue
+		}
+		if value, ok := annotation.Parameter("SeeAllData"); ok {
+			if literal, ok := value.(*ast.BooleanLiteral); ok && literal.Value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func runTest(classTypes []*ast.ClassType, classType *ast.ClassType, m *ast.Method, i int) error {
 	action := fmt.Sprintf("%s#%s", classType.Name, m.Name)
 	fmt.Printf("(%d) %s: ", i, action)
@@ -846,6 +1156,8 @@ func runTest(classTypes []*ast.ClassType, classType *ast.ClassType, m *ast.Metho
 	err := run(action, classTypes, func(i *interpreter.Interpreter) {
 		ret = i
 		i.Extra["stdout"] = new(bytes.Buffer)
+		i.Context.IsRunningTest = true
+		i.Context.SeeAllData = seeAllData(classType, m)
 	})
 	if err != nil {
 		return err
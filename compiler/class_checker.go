@@ -11,9 +11,15 @@ import (
 )
 
 func CheckClass(t *ast.ClassType) error {
+	if t.IsTrigger() {
+		return nil
+	}
 	if err := checkTopLevelType(t); err != nil {
 		return err
 	}
+	if t.IsEnum() {
+		return nil
+	}
 	if err := checkConstructorName(t); err != nil {
 		return err
 	}
@@ -23,6 +29,9 @@ func CheckClass(t *ast.ClassType) error {
 	if err := checkExtends(t); err != nil {
 		return err
 	}
+	if err := checkExceptionName(t); err != nil {
+		return err
+	}
 	if err := checkAbstractMethods(t.InstanceMethods); err != nil {
 		return err
 	}
@@ -58,6 +67,37 @@ func CheckClass(t *ast.ClassType) error {
 	if err := checkOverrideField(t); err != nil {
 		return err
 	}
+	if err := checkFutureMethods(t); err != nil {
+		return err
+	}
+	return nil
+}
+
+// future methods are queued, so that they can not return a value,
+// and the records may be changed before they run
+func checkFutureMethods(t *ast.ClassType) error {
+	for _, methods := range t.InstanceMethods.All() {
+		for _, m := range methods {
+			if m.IsAnnotated("future") {
+				return fmt.Errorf("Future methods must be static: %s", m.Name)
+			}
+		}
+	}
+	for _, methods := range t.StaticMethods.All() {
+		for _, m := range methods {
+			if !m.IsAnnotated("future") {
+				continue
+			}
+			if m.ReturnType != nil {
+				return fmt.Errorf("Future methods do not support return type of %s", m.ReturnType.String())
+			}
+			for _, p := range m.Parameters {
+				if builtin.Equals(builtin.SObjectType, p.Type) {
+					return fmt.Errorf("Unsupported parameter type %s", p.Type.String())
+				}
+			}
+		}
+	}
 	return nil
 }
 
@@ -123,6 +163,18 @@ func checkExtends(t *ast.ClassType) error {
 	return nil
 }
 
+func checkExceptionName(t *ast.ClassType) error {
+	isException := builtin.IsExceptionType(t)
+	hasExceptionName := strings.HasSuffix(strings.ToLower(t.Name), "exception")
+	if hasExceptionName && !isException {
+		return fmt.Errorf("Exception class must extend another Exception class: %s", t.Name)
+	}
+	if !hasExceptionName && isException {
+		return fmt.Errorf("Classes extending Exception must have a name ending in 'Exception': %s", t.Name)
+	}
+	return nil
+}
+
 func checkImplements(t *ast.ClassType) error {
 	if len(t.ImplementClasses) == 0 {
 		return nil
@@ -136,6 +188,9 @@ func checkImplements(t *ast.ClassType) error {
 					return fmt.Errorf("Class %s must implement the method: %s", t.Name, MethodSignature(impl, method))
 				}
 				matchedMethod = builtin.SearchMethod(impl, instanceMethods, ParameterClassTypes(method.Parameters))
+				if matchedMethod == nil {
+					matchedMethod = searchCovariantMethod(impl, instanceMethods, method)
+				}
 				if matchedMethod == nil {
 					return fmt.Errorf("Class %s must implement the method: %s", t.Name, MethodSignature(impl, method))
 				}
@@ -145,6 +200,17 @@ func checkImplements(t *ast.ClassType) error {
 	return nil
 }
 
+// searchCovariantMethod searches the implementation whose parameters are subtypes of the interface method,
+// such as execute(Database.BatchableContext, List<Account>) for Database.Batchable<SObject>
+func searchCovariantMethod(impl *ast.ClassType, methods []*ast.Method, method *ast.Method) *ast.Method {
+	for _, m := range methods {
+		if builtin.SearchMethod(impl, []*ast.Method{method}, ParameterClassTypes(m.Parameters)) != nil {
+			return m
+		}
+	}
+	return nil
+}
+
 func checkAbstractMethods(m *ast.MethodMap) error {
 	for _, methods := range m.All() {
 		for _, method := range methods {
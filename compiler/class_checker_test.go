@@ -203,6 +203,83 @@ func TestClassChecker(t *testing.T) {
 			},
 			errors.New("parameter name is duplicated: a"),
 		},
+		// exception name without extending Exception
+		{
+			&ast.ClassType{
+				Modifiers:       []*ast.Modifier{ast.PublicModifier()},
+				Annotations:     []*ast.Annotation{},
+				Name:            "FooException",
+				SuperClassRef:   nil,
+				InstanceFields:  ast.NewFieldMap(),
+				StaticFields:    ast.NewFieldMap(),
+				InstanceMethods: ast.NewMethodMap(),
+				StaticMethods:   ast.NewMethodMap(),
+			},
+			errors.New("Exception class must extend another Exception class: FooException"),
+		},
+		// extending Exception without exception name
+		{
+			&ast.ClassType{
+				Modifiers:       []*ast.Modifier{ast.PublicModifier()},
+				Annotations:     []*ast.Annotation{},
+				Name:            "Foo",
+				SuperClass:      builtin.ExceptionType,
+				InstanceFields:  ast.NewFieldMap(),
+				StaticFields:    ast.NewFieldMap(),
+				InstanceMethods: ast.NewMethodMap(),
+				StaticMethods:   ast.NewMethodMap(),
+			},
+			errors.New("Classes extending Exception must have a name ending in 'Exception': Foo"),
+		},
+		// future method with return type
+		{
+			&ast.ClassType{
+				Modifiers:       []*ast.Modifier{ast.PublicModifier()},
+				Annotations:     []*ast.Annotation{},
+				Name:            "Foo",
+				InstanceFields:  ast.NewFieldMap(),
+				StaticFields:    ast.NewFieldMap(),
+				InstanceMethods: ast.NewMethodMap(),
+				StaticMethods: &ast.MethodMap{
+					Data: map[string][]*ast.Method{
+						"bar": {
+							&ast.Method{
+								Name:        "bar",
+								ReturnType:  builtin.IntegerType,
+								Modifiers:   []*ast.Modifier{ast.PublicModifier(), {Name: "static"}},
+								Annotations: []*ast.Annotation{{Name: "future"}},
+								Parameters:  []*ast.Parameter{},
+							},
+						},
+					},
+				},
+			},
+			errors.New("Future methods do not support return type of Integer"),
+		},
+		// future instance method
+		{
+			&ast.ClassType{
+				Modifiers:      []*ast.Modifier{ast.PublicModifier()},
+				Annotations:    []*ast.Annotation{},
+				Name:           "Foo",
+				InstanceFields: ast.NewFieldMap(),
+				StaticFields:   ast.NewFieldMap(),
+				InstanceMethods: &ast.MethodMap{
+					Data: map[string][]*ast.Method{
+						"bar": {
+							&ast.Method{
+								Name:        "bar",
+								Modifiers:   []*ast.Modifier{ast.PublicModifier()},
+								Annotations: []*ast.Annotation{{Name: "future"}},
+								Parameters:  []*ast.Parameter{},
+							},
+						},
+					},
+				},
+				StaticMethods: ast.NewMethodMap(),
+			},
+			errors.New("Future methods must be static: bar"),
+		},
 	}
 	for i, testCase := range testCases {
 		err := CheckClass(testCase.Input)
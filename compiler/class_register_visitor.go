@@ -2,7 +2,9 @@ package compiler
 
 import (
 	"fmt"
+
 	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
 )
 
 type ClassRegisterVisitor struct{}
@@ -25,6 +27,15 @@ func (v *ClassRegisterVisitor) VisitClassDeclaration(n *ast.ClassDeclaration) (i
 	return t, nil
 }
 
+func (v *ClassRegisterVisitor) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	t := builtin.CreateEnumType(n.Name, n.Identifiers)
+	t.Modifiers = n.Modifiers
+	t.Location = n.Location
+	t.Annotations = n.Annotations
+	t.Parent = n.Parent
+	return t, nil
+}
+
 func (v *ClassRegisterVisitor) VisitModifier(n *ast.Modifier) (interface{}, error) {
 	return ast.VisitModifier(v, n)
 }
@@ -172,8 +183,36 @@ func (v *ClassRegisterVisitor) VisitSwitch(n *ast.Switch) (interface{}, error) {
 	return ast.VisitSwitch(v, n)
 }
 
+// VisitTrigger registers trigger as a class whose only static method is the body of trigger,
+// the method takes Trigger as parameter which is typed by TypeRefResolver
 func (v *ClassRegisterVisitor) VisitTrigger(n *ast.Trigger) (interface{}, error) {
-	return ast.VisitTrigger(v, n)
+	t := &ast.ClassType{}
+	t.Name = n.Name
+	t.Modifiers = []*ast.Modifier{}
+	t.InnerClasses = ast.NewClassMap()
+	t.Location = n.Location
+	t.Trigger = n
+	t.InstanceFields = ast.NewFieldMap()
+	t.StaticFields = ast.NewFieldMap()
+	t.InstanceMethods = ast.NewMethodMap()
+	t.StaticMethods = ast.NewMethodMap()
+	t.Constructors = []*ast.Method{}
+	t.StaticMethods.Set(n.Name, []*ast.Method{
+		{
+			Name:      n.Name,
+			Modifiers: []*ast.Modifier{},
+			Parameters: []*ast.Parameter{
+				{
+					Name:     "Trigger",
+					Location: n.Location,
+				},
+			},
+			Statements: n.Statements,
+			Location:   n.Location,
+			Parent:     t,
+		},
+	})
+	return t, nil
 }
 
 func (v *ClassRegisterVisitor) VisitTriggerTiming(n *ast.TriggerTiming) (interface{}, error) {
@@ -374,6 +413,16 @@ func (v *ClassRegisterVisitor) setDeclaration(declarations []ast.Node, t *ast.Cl
 				return fmt.Errorf("Class %s is already defined", class.Name)
 			}
 			t.InnerClasses.Set(class.Name, class)
+		case *ast.EnumDeclaration:
+			r, err := decl.Accept(v)
+			if err != nil {
+				return err
+			}
+			enum := r.(*ast.ClassType)
+			if _, ok := t.InnerClasses.Get(enum.Name); ok {
+				return fmt.Errorf("Class %s is already defined", enum.Name)
+			}
+			t.InnerClasses.Set(enum.Name, enum)
 		}
 	}
 	return nil
@@ -24,6 +24,14 @@ func NewTypeChecker() *TypeChecker {
 }
 
 func (v *TypeChecker) VisitClassType(n *ast.ClassType) (interface{}, error) {
+	if n.IsEnum() {
+		for _, f := range n.StaticFields.Data {
+			if isInvalidIdentifier(f.Name) {
+				return nil, invalidIdentifier(f.Name)
+			}
+		}
+		return nil, nil
+	}
 	v.Context.CurrentClass = n
 	if n.StaticFields != nil {
 		for _, f := range n.StaticFields.Data {
@@ -120,6 +128,10 @@ func (v *TypeChecker) VisitInterfaceDeclaration(n *ast.InterfaceDeclaration) (in
 	return ast.VisitInterfaceDeclaration(v, n)
 }
 
+func (v *TypeChecker) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	panic("Not pass")
+}
+
 func (v *TypeChecker) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
 	return builtin.IntegerType, nil
 }
@@ -148,7 +160,7 @@ func (v *TypeChecker) VisitArrayAccess(n *ast.ArrayAccess) (interface{}, error)
 		return nil, v.compileError("generics is not specified", n)
 	}
 	if klass.Name == "Map" {
-		if t != builtin.StringType {
+		if t != builtin.StringType && t != builtin.IdType {
 			v.AddError(fmt.Sprintf("map key <%v> must be String", t.(*ast.ClassType).String()), n.Key)
 		}
 		return generics[1], nil
@@ -197,22 +209,41 @@ func (v *TypeChecker) VisitFieldDeclaration(n *ast.FieldDeclaration) (interface{
 	panic("not pass")
 }
 
+// VisitTry returns the return type when every path of the try statement returns a value
 func (v *TypeChecker) VisitTry(n *ast.Try) (interface{}, error) {
-	n.Block.Accept(v)
+	r, err := n.Block.Accept(v)
+	if err != nil {
+		return nil, err
+	}
 	for _, c := range n.CatchClause {
-		c.Accept(v)
+		catchRet, err := c.Accept(v)
+		if err != nil {
+			return nil, err
+		}
+		if catchRet == nil {
+			r = nil
+		}
 	}
-	n.FinallyBlock.Accept(v)
-	return nil, nil
+	if n.FinallyBlock != nil {
+		finallyRet, err := n.FinallyBlock.Accept(v)
+		if err != nil {
+			return nil, err
+		}
+		if finallyRet != nil {
+			return finallyRet, nil
+		}
+	}
+	return r, nil
 }
 
 func (v *TypeChecker) VisitCatch(n *ast.Catch) (interface{}, error) {
-	v.Context.Env.Set(n.Identifier, n.Type) // TODO: append scope
-	_, err := n.Block.Accept(v)
-	if err != nil {
-		return nil, err
+	if !builtin.IsExceptionType(n.Type) {
+		v.AddError(fmt.Sprintf("Catch type must be of type exception: %s", n.Type.String()), n)
 	}
-	return nil, nil
+	return v.NewEnv(func() (interface{}, error) {
+		v.Context.Env.Set(n.Identifier, n.Type)
+		return n.Block.Accept(v)
+	})
 }
 
 func (v *TypeChecker) VisitFinally(n *ast.Finally) (interface{}, error) {
@@ -280,6 +311,10 @@ func (v *TypeChecker) VisitEnhancedForControl(n *ast.EnhancedForControl) (interf
 	}
 
 	genericsType := expClassType.Generics[0]
+	// the records of List<SObject> are iterated as the concrete sObject, such as the result of Database.query
+	if genericsType == builtin.SObjectType && builtin.Equals(builtin.SObjectType, declClassType) {
+		return nil, nil
+	}
 	if !builtin.Equals(declClassType, genericsType) {
 		v.AddError(fmt.Sprintf("expression <%s> must be <%s> expression", declClassType.String(), expClassType.String()), n)
 	}
@@ -550,14 +585,15 @@ func (v *TypeChecker) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 			}
 			l = left.(*ast.ClassType)
 		}
-		if r != nil && !builtin.Equals(l, r.(*ast.ClassType)) {
-			v.AddError(fmt.Sprintf("Illegal assignment from %s to %s", r.(*ast.ClassType).String(), l.String()), n.Left)
-		}
 		if soql, ok := n.Right.(*ast.Soql); ok {
 			if l.SuperClass == builtin.SObjectType {
 				soql.ExactlyOne = true
+				r = l
 			}
 		}
+		if r != nil && !builtin.Equals(l, r.(*ast.ClassType)) {
+			v.AddError(fmt.Sprintf("Illegal assignment from %s to %s", r.(*ast.ClassType).String(), l.String()), n.Left)
+		}
 		return l, nil
 	} else {
 		l, err := n.Left.Accept(v)
@@ -565,6 +601,13 @@ func (v *TypeChecker) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 			return nil, err
 		}
 		if n.Op == "+" {
+			// Id is concatenated as String
+			if l == builtin.IdType {
+				l = builtin.StringType
+			}
+			if r == builtin.IdType {
+				r = builtin.StringType
+			}
 			if l != builtin.IntegerType && l != builtin.StringType && l != builtin.DoubleType {
 				v.AddError(fmt.Sprintf("expression <%s> must be Integer, String or Double", l.(*ast.ClassType).String()), n.Left)
 			}
@@ -636,15 +679,8 @@ func (v *TypeChecker) VisitThrow(n *ast.Throw) (interface{}, error) {
 	}
 	// Check Subclass of Exception
 	baseClass := r.(*ast.ClassType)
-	super := baseClass.SuperClass
-	if super == nil {
+	if !builtin.IsExceptionType(baseClass) {
 		v.AddError(fmt.Sprintf("Throw expression must be of type exception: %s", baseClass.String()), n)
-	} else {
-		if err != nil {
-			v.AddError(err.Error(), n)
-		} else if super != builtin.ExceptionType {
-			v.AddError(fmt.Sprintf("Throw expression must be of type exception: %s", baseClass.String()), n)
-		}
 	}
 	return nil, nil
 }
@@ -655,14 +691,116 @@ func (v *TypeChecker) VisitSoql(n *ast.Soql) (interface{}, error) {
 	if err != nil {
 		return nil, v.compileError(err.Error(), n)
 	}
+	// the fields and the relationships are checked against the sObjects of the metafile
+	if err := builtin.ValidateQuery(n); err != nil {
+		v.AddError(err.Error(), n)
+	}
+	if builtin.IsAggregateQuery(n) {
+		v.checkAggregateQuery(n, t)
+		if builtin.IsCountQuery(n) {
+			return builtin.IntegerType, nil
+		}
+		t = builtin.AggregateResultType
+	}
 	return &ast.ClassType{
 		Name:     "List",
 		Generics: []*ast.ClassType{t},
 	}, nil
 }
 
+// checkAggregateQuery checks the aggregate functions and that the fields are grouped or aggregated,
+// and that the fields of ORDER BY are selected if the query is grouped by ROLLUP or CUBE
+func (v *TypeChecker) checkAggregateQuery(n *ast.Soql, sObjectType *ast.ClassType) {
+	if err := builtin.GroupingSetsOrderError(n); err != nil {
+		v.AddError(err.Error(), n)
+	}
+	var groupFields []ast.Node
+	if n.Group != nil {
+		groupFields = n.Group.Fields
+		if len(groupFields) == 1 {
+			if f, ok := groupFields[0].(*ast.SoqlFunction); ok && (strings.EqualFold(f.Name, "rollup") || strings.EqualFold(f.Name, "cube")) {
+				groupFields = f.Fields
+			}
+		}
+	}
+	for _, field := range n.SelectFields {
+		switch f := field.(type) {
+		case *ast.SelectField:
+			if !isGroupField(groupFields, f) {
+				v.AddError(fmt.Sprintf("Field must be grouped or aggregated: %s", strings.Join(f.Value, ".")), f)
+			}
+		case *ast.SoqlFunction:
+			if len(f.Fields) == 0 {
+				if !strings.EqualFold(f.Name, "count") {
+					v.AddError(fmt.Sprintf("%s() requires a field", f.Name), f)
+				} else if len(n.SelectFields) > 1 || n.Group != nil {
+					v.AddError("COUNT() must be the only element in the SELECT list", f)
+				}
+				continue
+			}
+			var fieldType *ast.ClassType
+			if selectField, ok := f.Fields[0].(*ast.SelectField); ok && len(selectField.Value) == 1 {
+				if sObjectField, ok := sObjectType.InstanceFields.Get(selectField.Value[0]); ok {
+					fieldType = sObjectField.Type
+				}
+			}
+			if _, ok := builtin.AggregateFunctionType(f.Name, fieldType); !ok {
+				v.AddError(fmt.Sprintf("Unknown aggregate function %s", f.Name), f)
+				continue
+			}
+			switch strings.ToLower(f.Name) {
+			case "sum", "avg":
+				if fieldType != nil && fieldType != builtin.IntegerType && fieldType != builtin.DoubleType {
+					v.AddError(fmt.Sprintf("field <%s> must be Integer or Double for %s", fieldType.String(), f.Name), f)
+				}
+			case "grouping":
+				if !isGroupField(groupFields, f.Fields[0]) {
+					v.AddError("GROUPING() requires a field of GROUP BY", f)
+				}
+			}
+		}
+	}
+}
+
+// isGroupField returns whether the field is one of GROUP BY, which are compared case insensitively
+func isGroupField(groupFields []ast.Node, field ast.Node) bool {
+	selectField, ok := field.(*ast.SelectField)
+	if !ok {
+		return false
+	}
+	for _, groupField := range groupFields {
+		if g, ok := groupField.(*ast.SelectField); ok && strings.EqualFold(strings.Join(g.Value, "."), strings.Join(selectField.Value, ".")) {
+			return true
+		}
+	}
+	return false
+}
+
 func (v *TypeChecker) VisitSosl(n *ast.Sosl) (interface{}, error) {
-	return ast.VisitSosl(v, n)
+	term := n.SearchTerm
+	if bindVariable, ok := term.(*ast.SoqlBindVariable); ok {
+		term = bindVariable.Expression
+	}
+	t, err := term.Accept(v)
+	if err != nil {
+		return nil, err
+	}
+	if t != nil && !builtin.Equals(t.(*ast.ClassType), builtin.StringType) {
+		v.AddError(fmt.Sprintf("search term <%s> must be String", t.(*ast.ClassType).String()), n.SearchTerm)
+	}
+	isSearchGroup := false
+	for _, group := range builtin.SearchGroups {
+		isSearchGroup = isSearchGroup || group == n.SearchGroup
+	}
+	if !isSearchGroup {
+		v.AddError(fmt.Sprintf("Unknown search group %s FIELDS", n.SearchGroup), n)
+	}
+	for _, returning := range n.Returning {
+		if _, err := returning.Accept(v); err != nil {
+			return nil, err
+		}
+	}
+	return builtin.CreateListType(builtin.CreateListType(builtin.SObjectType)), nil
 }
 
 func (v *TypeChecker) VisitStringLiteral(n *ast.StringLiteral) (interface{}, error) {
@@ -674,13 +812,32 @@ func (v *TypeChecker) VisitSwitch(n *ast.Switch) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	for _, w := range n.WhenStatements {
-		t, err := w.Accept(v)
-		if err != nil {
-			return nil, err
+	if classType, ok := exp.(*ast.ClassType); ok && classType.IsEnum() {
+		for _, w := range n.WhenStatements {
+			for _, c := range w.Condition {
+				name, ok := c.(*ast.Name)
+				if !ok || len(name.Value) != 1 || builtin.EnumValueOf(classType, name.Value[0]) == nil {
+					v.AddError(fmt.Sprintf("when value must be a constant of enum <%s>", classType.String()), c)
+				}
+			}
+			if _, err := w.Statements.Accept(v); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		for _, w := range n.WhenStatements {
+			t, err := w.Accept(v)
+			if err != nil {
+				return nil, err
+			}
+			if t != exp {
+				// v.Errors
+			}
 		}
-		if t != exp {
-			// v.Errors
+	}
+	if n.ElseStatement != nil {
+		if _, err := n.ElseStatement.Accept(v); err != nil {
+			return nil, err
 		}
 	}
 	return nil, nil
@@ -707,14 +864,15 @@ func (v *TypeChecker) VisitVariableDeclaration(n *ast.VariableDeclaration) (inte
 			continue
 		}
 		v.Context.Env.Set(d.Name, n.Type)
-		if !builtin.Equals(n.Type, t.(*ast.ClassType)) {
-			v.AddError(fmt.Sprintf("Illegal assignment from %s to %s", t.(*ast.ClassType).String(), n.Type.String()), n)
-		}
 		if soql, ok := d.Expression.(*ast.Soql); ok {
 			if n.Type.SuperClass == builtin.SObjectType {
 				soql.ExactlyOne = true
+				t = n.Type
 			}
 		}
+		if !builtin.Equals(n.Type, t.(*ast.ClassType)) {
+			v.AddError(fmt.Sprintf("Illegal assignment from %s to %s", t.(*ast.ClassType).String(), n.Type.String()), n)
+		}
 	}
 	return nil, nil
 }
@@ -776,9 +934,13 @@ func (v *TypeChecker) VisitFieldAccess(n *ast.FieldAccess) (interface{}, error)
 	return f.Type, nil
 }
 
+// VisitType checks `Foo.class`, which is System.Type
 func (v *TypeChecker) VisitType(n *ast.TypeRef) (interface{}, error) {
 	resolver := NewTypeResolver(v.Context)
-	return resolver.ConvertType(n)
+	if _, err := resolver.ConvertType(n); err != nil {
+		return nil, v.compileError(err.Error(), n)
+	}
+	return builtin.TypeType, nil
 }
 
 func (v *TypeChecker) VisitBlock(n *ast.Block) (interface{}, error) {
@@ -792,7 +954,8 @@ func (v *TypeChecker) VisitBlock(n *ast.Block) (interface{}, error) {
 			}
 		}
 		if len(n.Statements) > 0 {
-			if _, ok := n.Statements[len(n.Statements)-1].(*ast.Return); ok {
+			switch n.Statements[len(n.Statements)-1].(type) {
+			case *ast.Return, *ast.Try:
 				return r, nil
 			}
 		}
@@ -973,6 +1136,7 @@ func (v *TypeChecker) checkAddError(n *ast.MethodInvocation, receiver *ast.Class
 func isTypeSObjectField(classType *ast.ClassType) bool {
 	return classType == builtin.IntegerType ||
 		classType == builtin.StringType ||
+		classType == builtin.IdType ||
 		classType == builtin.BooleanType ||
 		classType == builtin.DateType ||
 		classType == builtin.DoubleType
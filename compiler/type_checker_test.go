@@ -622,6 +622,92 @@ func TestModifier(t *testing.T) {
 	}
 }
 
+func TestTypeCheckerAggregateQuery(t *testing.T) {
+	field := func(name string) *ast.SelectField {
+		return &ast.SelectField{Value: []string{name}}
+	}
+	function := func(name string, fields ...ast.Node) *ast.SoqlFunction {
+		return &ast.SoqlFunction{Name: name, Fields: fields}
+	}
+	opportunity := &ast.ClassType{Name: "Opportunity", InstanceFields: ast.NewFieldMap()}
+	opportunity.InstanceFields.Set("name", &ast.Field{Name: "Name", Type: builtin.StringType})
+	opportunity.InstanceFields.Set("amount", &ast.Field{Name: "Amount", Type: builtin.DoubleType})
+	opportunity.InstanceFields.Set("stagename", &ast.Field{Name: "StageName", Type: builtin.StringType})
+
+	testCases := []struct {
+		Input        *ast.Soql
+		ExpectType   string
+		ExpectErrors []string
+	}{
+		{
+			&ast.Soql{SelectFields: []ast.Node{function("count")}},
+			"Integer",
+			[]string{},
+		},
+		{
+			&ast.Soql{
+				SelectFields: []ast.Node{field("StageName"), function("sum", field("Amount")), function("grouping", field("StageName"))},
+				Group:        &ast.Group{Fields: []ast.Node{function("rollup", field("stagename"))}},
+			},
+			"List<AggregateResult>",
+			[]string{},
+		},
+		{
+			&ast.Soql{
+				SelectFields: []ast.Node{field("Name"), function("count"), function("avg", field("Name")), function("median", field("Amount")), function("max")},
+				Group:        &ast.Group{Fields: []ast.Node{field("StageName")}},
+			},
+			"List<AggregateResult>",
+			[]string{
+				"COUNT() must be the only element in the SELECT list",
+				"Field must be grouped or aggregated: Name",
+				"Unknown aggregate function median",
+				"field <String> must be Integer or Double for avg",
+				"max() requires a field",
+			},
+		},
+		{
+			&ast.Soql{
+				SelectFields: []ast.Node{function("grouping", field("Name"))},
+				Group:        &ast.Group{Fields: []ast.Node{field("StageName")}},
+			},
+			"List<AggregateResult>",
+			[]string{"GROUPING() requires a field of GROUP BY"},
+		},
+		{
+			&ast.Soql{
+				SelectFields: []ast.Node{field("StageName"), function("count", field("Name"))},
+				Group:        &ast.Group{Fields: []ast.Node{function("rollup", field("StageName"))}},
+				Order:        []*ast.Order{{Field: field("stagename")}, {Field: field("Name")}},
+			},
+			"List<AggregateResult>",
+			[]string{"ORDER BY field must be selected with ROLLUP or CUBE: Name"},
+		},
+	}
+	for i, testCase := range testCases {
+		checker := NewTypeChecker()
+		checker.Context = &Context{}
+		checker.Context.ClassTypes = builtin.NewClassMapWithPrimivie([]*ast.ClassType{opportunity})
+		testCase.Input.FromObject = "Opportunity"
+		r, err := checker.VisitSoql(testCase.Input)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err.Error())
+		}
+		if actual := r.(*ast.ClassType).String(); actual != testCase.ExpectType {
+			t.Errorf("%d: expected type: %s, actual: %s", i, testCase.ExpectType, actual)
+		}
+
+		messages := make([]string, len(checker.Errors))
+		for i, err := range checker.Errors {
+			messages[i] = err.Message
+		}
+		sort.Strings(messages)
+		if strings.Join(messages, ", ") != strings.Join(testCase.ExpectErrors, ", ") {
+			t.Errorf("%d: expected errors: %s, actual: %s", i, strings.Join(testCase.ExpectErrors, ", "), strings.Join(messages, ", "))
+		}
+	}
+}
+
 func newTestClassTypeWithStatement(statements []ast.Node) *ast.ClassType {
 	classType := &ast.ClassType{
 		Name: "klass",
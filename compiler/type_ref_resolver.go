@@ -1,6 +1,8 @@
 package compiler
 
 import (
+	"fmt"
+
 	"github.com/tzmfreedom/land/ast"
 	"github.com/tzmfreedom/land/builtin"
 )
@@ -19,6 +21,13 @@ func NewTypeRefResolver(classTypes *ast.ClassMap, nameSpaceStore *builtin.NameSp
 }
 
 func (v *TypeRefResolver) Resolve(n *ast.ClassType) (*ast.ClassType, error) {
+	// enum has only constants which type is itself
+	if n.IsEnum() {
+		return n, nil
+	}
+	if n.IsTrigger() {
+		return v.resolveTrigger(n)
+	}
 	if n.SuperClassRef != nil {
 		superClass, err := n.SuperClassRef.Accept(v)
 		if err != nil {
@@ -146,6 +155,10 @@ func (v *TypeRefResolver) VisitInterfaceDeclaration(n *ast.InterfaceDeclaration)
 	return ast.VisitInterfaceDeclaration(v, n)
 }
 
+func (v *TypeRefResolver) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	return ast.VisitEnumDeclaration(v, n)
+}
+
 func (v *TypeRefResolver) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
 	return ast.VisitIntegerLiteral(v, n)
 }
@@ -207,9 +220,11 @@ func (v *TypeRefResolver) VisitTry(n *ast.Try) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	_, err = n.FinallyBlock.Accept(v)
-	if err != nil {
-		return nil, err
+	if n.FinallyBlock != nil {
+		_, err = n.FinallyBlock.Accept(v)
+		if err != nil {
+			return nil, err
+		}
 	}
 	for _, c := range n.CatchClause {
 		_, err := c.Accept(v)
@@ -217,15 +232,15 @@ func (v *TypeRefResolver) VisitTry(n *ast.Try) (interface{}, error) {
 			return nil, err
 		}
 	}
-	return ast.VisitTry(v, n)
+	return nil, nil
 }
 
 func (v *TypeRefResolver) VisitCatch(n *ast.Catch) (interface{}, error) {
 	classType, err := n.TypeRef.Accept(v)
-	n.Type = classType.(*ast.ClassType)
 	if err != nil {
 		return nil, err
 	}
+	n.Type = classType.(*ast.ClassType)
 	return n.Block.Accept(v)
 }
 
@@ -390,6 +405,27 @@ func (v *TypeRefResolver) VisitSwitch(n *ast.Switch) (interface{}, error) {
 	return nil, nil
 }
 
+// resolveTrigger types Trigger in the body of trigger by the SObject of trigger
+func (v *TypeRefResolver) resolveTrigger(n *ast.ClassType) (*ast.ClassType, error) {
+	sObjectType, err := v.resolver.ResolveType([]string{n.Trigger.Object})
+	if err != nil {
+		return nil, err
+	}
+	if sObjectType.SuperClass != builtin.SObjectType {
+		return nil, fmt.Errorf("Invalid SObject type: %s", n.Trigger.Object)
+	}
+	v.resolver.CurrentClass = n
+	for _, methods := range n.StaticMethods.All() {
+		for _, m := range methods {
+			m.Parameters[0].Type = builtin.CreateTriggerContextType(sObjectType)
+			if _, err := m.Statements.Accept(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return n, nil
+}
+
 func (v *TypeRefResolver) VisitTrigger(n *ast.Trigger) (interface{}, error) {
 	return n.Statements.Accept(v)
 }
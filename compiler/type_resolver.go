@@ -86,16 +86,17 @@ func (r *TypeResolver) ResolveVariable(names []string, checkSetter bool) (*ast.C
 			}
 			return fieldType, nil
 		}
-		if v, ok := r.Context.ClassTypes.Get(name); ok {
+		// the error of the static field is returned if the name is not the static field of an inner class either
+		var staticFieldErr error
+		if v, ok := r.Context.ClassTypes.Get(name); ok && !r.isNameSpaceClass(names) {
 			check := false
 			if len(names) == 2 {
 				check = checkSetter
 			}
 			n, err := FindStaticField(v, names[1], MODIFIER_PUBLIC_ONLY, check)
 			if err != nil {
-				return nil, err
-			}
-			if n != nil {
+				staticFieldErr = err
+			} else if n != nil {
 				var instanceField *ast.Field
 				var err error
 				fieldType := n.Type
@@ -149,6 +150,40 @@ func (r *TypeResolver) ResolveVariable(names []string, checkSetter bool) (*ast.C
 				}
 			}
 		}
+		// search for static field of inner class, such as enum constant
+		for i := 1; i < len(names); i++ {
+			classType, err := r.ResolveType(names[:i])
+			if err != nil {
+				continue
+			}
+			check := false
+			if len(names)-1 == i {
+				check = checkSetter
+			}
+			field, err := FindStaticField(classType, names[i], MODIFIER_PUBLIC_ONLY, check)
+			if err != nil || field == nil {
+				continue
+			}
+			fieldType := field.Type
+			for j, f := range names[i+1:] {
+				check := false
+				if len(names)-i-2 == j {
+					check = checkSetter
+				}
+				instanceField, err := FindInstanceField(fieldType, f, MODIFIER_PUBLIC_ONLY, check)
+				if err != nil {
+					return nil, err
+				}
+				if instanceField == nil {
+					return nil, fmt.Errorf("Field %s is not found", f)
+				}
+				fieldType = instanceField.Type
+			}
+			return fieldType, nil
+		}
+		if staticFieldErr != nil {
+			return nil, staticFieldErr
+		}
 	}
 	return nil, fmt.Errorf("local variable %s is not found", names[0])
 }
@@ -233,6 +268,15 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.ClassType
 				}
 			}
 		}
+
+		// search for static method of inner class, or instance method of its static field
+		receiverNames := names[:len(names)-1]
+		if classType, err := r.ResolveType(receiverNames); err == nil {
+			return FindStaticMethod(classType, methodName, parameters, MODIFIER_PUBLIC_ONLY)
+		}
+		if fieldType, err := r.ResolveVariable(receiverNames, false); err == nil {
+			return FindInstanceMethod(fieldType, methodName, parameters, MODIFIER_PUBLIC_ONLY)
+		}
 	}
 	return nil, nil, errors.Errorf("%s is not found in this scope", strings.Join(names, "."))
 }
@@ -313,6 +357,17 @@ func FindInstanceField(classType *ast.ClassType, fieldName string, allowedModifi
 	return nil, fieldNotFoundError(classType, fieldName)
 }
 
+// isNameSpaceClass reports whether names start with namespace and its class,
+// such as ApexPages.Severity, whose namespace has also a class of the same name
+func (r *TypeResolver) isNameSpaceClass(names []string) bool {
+	classMap, ok := r.Context.NameSpaces.Get(names[0])
+	if !ok {
+		return false
+	}
+	_, ok = classMap.Get(names[1])
+	return ok
+}
+
 func FindStaticField(classType *ast.ClassType, fieldName string, allowedModifier int, checkSetter bool) (*ast.Field, error) {
 	fieldType, ok := classType.StaticFields.Get(fieldName)
 	if ok {
@@ -0,0 +1,192 @@
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+)
+
+// MaxQueueableStackDepth is the depth of the chained queueable jobs,
+// which is the same as the limit of Developer Edition and trial orgs
+var MaxQueueableStackDepth = 5
+
+// AsyncJob is a work executed asynchronously, such as future methods, queueable, batch and scheduled jobs.
+// The jobs are executed after the transaction, or at Test.stopTest in test.
+type AsyncJob func() error
+
+// RunningJob is the asynchronous job being executed, which has the record of AsyncApexJob
+type RunningJob struct {
+	Record    *builtin.AsyncApexJob
+	Depth     int  // depth of the chained queueable jobs
+	Callout   bool // whether the job is allowed to make callouts
+	Finishing bool // whether the batch is in finish, which can execute the next batch
+}
+
+func (v *Interpreter) EnqueueAsyncJob(job AsyncJob) {
+	v.Context.AsyncJobs = append(v.Context.AsyncJobs, job)
+}
+
+// RunAsyncJobs executes the queued jobs in order with the asynchronous limits,
+// including the jobs enqueued by the executed jobs.
+// A failed job does not stop the others, the first error is returned after all jobs are executed.
+func (v *Interpreter) RunAsyncJobs() error {
+	var firstErr error
+	for len(v.Context.AsyncJobs) > 0 {
+		job := v.Context.AsyncJobs[0]
+		v.Context.AsyncJobs = v.Context.AsyncJobs[1:]
+		if err := v.runAsyncJob(job); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (v *Interpreter) runAsyncJob(job AsyncJob) error {
+	prevLimits := v.Context.Limits
+	prevSavepoints := v.Context.Savepoints
+	v.Context.Limits = builtin.NewLimits(builtin.AsyncLimitThresholds)
+	v.Context.Savepoints = nil
+	defer func() {
+		v.Context.Limits = prevLimits
+		v.Context.Savepoints = prevSavepoints
+	}()
+	return job()
+}
+
+// enqueueRecordedJob queues the job and saves its AsyncApexJob record, whose status follows the execution.
+// The bodies of the job Ids are numbered in the order of enqueueing, so that the tests are reproducible.
+func (v *Interpreter) enqueueRecordedJob(job *RunningJob, run func() error) (string, error) {
+	v.Context.AsyncJobCount++
+	record := job.Record
+	record.Id = numberedId("AsyncApexJob", v.Context.AsyncJobCount)
+	record.Status = builtin.AsyncJobStatusQueued
+	if parent := v.Context.RunningJob; parent != nil {
+		record.ParentJobId = parent.Record.Id
+	}
+	if err := record.Insert(); err != nil {
+		return "", err
+	}
+	v.Context.AsyncApexJobs[record.Id] = record
+	v.EnqueueAsyncJob(func() error {
+		if record.Status == builtin.AsyncJobStatusAborted {
+			return nil
+		}
+		prev := v.Context.RunningJob
+		v.Context.RunningJob = job
+		defer func() {
+			v.Context.RunningJob = prev
+		}()
+
+		record.Status = builtin.AsyncJobStatusProcessing
+		if err := record.Update(); err != nil {
+			return err
+		}
+		err := run()
+		// the batch job completes by itself even if some chunks fail
+		if record.Status == builtin.AsyncJobStatusProcessing {
+			if err != nil {
+				exception := raisedException(nil, err)
+				if exception == nil {
+					return err
+				}
+				record.Fail(exception)
+			} else {
+				record.Status = builtin.AsyncJobStatusCompleted
+			}
+		}
+		if err := record.Update(); err != nil {
+			return err
+		}
+		return err
+	})
+	return record.Id, nil
+}
+
+// numberedId returns the 18 characters Id of the sObject whose body is the number
+func numberedId(sObjectType string, number int) string {
+	return builtin.To18(fmt.Sprintf("%s%012d", builtin.KeyPrefix(sObjectType), number))
+}
+
+// enqueueFutureMethod queues the invocation of the method annotated with @future
+func (v *Interpreter) enqueueFutureMethod(n ast.Node, receiver interface{}, m *ast.Method, evaluated []*ast.Object) error {
+	if v.IsFuture() || v.IsBatch() {
+		classType := receiver.(*ast.ClassType)
+		message := fmt.Sprintf("Future method cannot be called from a future or batch method: %s.%s", classType.Name, m.Name)
+		return v.raiseSystemException(builtin.AsyncExceptionType, n, message)
+	}
+	if exception := v.Context.Limits.AddFutureCall(); exception != nil {
+		return v.raiseLimitException(exception, n)
+	}
+	callout := false
+	if value, ok := m.Annotation("future").Parameter("callout"); ok {
+		if literal, ok := value.(*ast.BooleanLiteral); ok {
+			callout = literal.Value
+		}
+	}
+	job := &RunningJob{
+		Record: &builtin.AsyncApexJob{
+			JobType:    builtin.AsyncJobTypeFuture,
+			MethodName: m.Name,
+		},
+		Callout: callout,
+	}
+	_, err := v.enqueueRecordedJob(job, func() error {
+		_, err := v.callMethod(n, receiver, m, evaluated)
+		return err
+	})
+	return err
+}
+
+// EnqueueJob queues the Queueable object for System.enqueueJob
+func (v *Interpreter) EnqueueJob(queueable *ast.Object) (string, error) {
+	if exception := v.Context.Limits.AddQueueableJob(); exception != nil {
+		return "", builtin.NewRaiseError(exception)
+	}
+	depth := 1
+	if v.IsQueueable() {
+		depth = v.Context.RunningJob.Depth + 1
+	}
+	if depth > MaxQueueableStackDepth {
+		return "", builtin.NewRaiseError(builtin.NewException(builtin.AsyncExceptionType, "Maximum stack depth has been reached."))
+	}
+	job := &RunningJob{
+		Record: &builtin.AsyncApexJob{
+			JobType: builtin.AsyncJobTypeQueueable,
+		},
+		Depth:   depth,
+		Callout: true,
+	}
+	return v.enqueueRecordedJob(job, func() error {
+		context := builtin.NewQueueableContext(job.Record.Id)
+		_, err := v.InvokeMethod(queueable, "execute", []*ast.Object{context})
+		return err
+	})
+}
+
+func (v *Interpreter) IsFuture() bool {
+	job := v.Context.RunningJob
+	return job != nil && job.Record.JobType == builtin.AsyncJobTypeFuture
+}
+
+func (v *Interpreter) IsQueueable() bool {
+	job := v.Context.RunningJob
+	return job != nil && job.Record.JobType == builtin.AsyncJobTypeQueueable
+}
+
+func (v *Interpreter) IsBatch() bool {
+	job := v.Context.RunningJob
+	return job != nil && job.Record.JobType == builtin.AsyncJobTypeBatch
+}
+
+func (v *Interpreter) IsScheduled() bool {
+	job := v.Context.RunningJob
+	return job != nil && job.Record.JobType == builtin.AsyncJobTypeScheduled
+}
+
+// CalloutAllowed reports whether the running job can make callouts, future methods need to be
+// annotated with @future(callout=true) and batches need to implement Database.AllowsCallouts
+func (v *Interpreter) CalloutAllowed() bool {
+	job := v.Context.RunningJob
+	return job == nil || job.Callout
+}
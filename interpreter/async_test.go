@@ -0,0 +1,50 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+)
+
+func TestStopTestRunsAsyncJobs(t *testing.T) {
+	interpreter := NewInterpreter(ast.NewClassMap())
+	interpreter.Context.Limits.AddQuery()
+
+	if err := interpreter.StartTest(); err != nil {
+		t.Fatal(err)
+	}
+	if queries := interpreter.Limits().Queries; queries != 0 {
+		t.Errorf("expected fresh limits, actual %d queries", queries)
+	}
+	executed := []string{}
+	interpreter.EnqueueAsyncJob(func() error {
+		executed = append(executed, "first")
+		if thresholds := interpreter.Limits().Thresholds; thresholds != builtin.AsyncLimitThresholds {
+			t.Errorf("expected async limits")
+		}
+		// jobs enqueued by a job are also executed
+		interpreter.EnqueueAsyncJob(func() error {
+			executed = append(executed, "second")
+			return nil
+		})
+		return nil
+	})
+	if len(executed) != 0 {
+		t.Errorf("jobs are executed before stopTest")
+	}
+	if err := interpreter.StopTest(); err != nil {
+		t.Fatal(err)
+	}
+	if len(executed) != 2 || executed[0] != "first" || executed[1] != "second" {
+		t.Errorf("unexpected executed jobs %v", executed)
+	}
+	if queries := interpreter.Limits().Queries; queries != 1 {
+		t.Errorf("expected limits before startTest, actual %d queries", queries)
+	}
+
+	err := interpreter.StartTest()
+	if exception := raisedException(nil, err); exception == nil || exception.ClassType != builtin.FinalExceptionType {
+		t.Errorf("expected FinalException, actual %v", err)
+	}
+}
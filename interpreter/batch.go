@@ -0,0 +1,197 @@
+package interpreter
+
+import (
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+)
+
+// ExecuteBatch queues the batch job for Database.executeBatch.
+// The state of the batch is copied at this point, as the instance is serialized on Salesforce.
+func (v *Interpreter) ExecuteBatch(batchable *ast.Object, scope int) (string, error) {
+	if v.IsFuture() || (v.IsBatch() && !v.Context.RunningJob.Finishing) {
+		message := "Database.executeBatch cannot be called from a batch start, batch execute, or future method."
+		return "", builtin.NewRaiseError(builtin.NewException(builtin.AsyncExceptionType, message))
+	}
+	state := copyObject(batchable, map[*ast.Object]*ast.Object{})
+	job := &RunningJob{
+		Record: &builtin.AsyncApexJob{
+			JobType: builtin.AsyncJobTypeBatch,
+		},
+		Callout: builtin.Implements(batchable.ClassType, builtin.AllowsCalloutsType),
+	}
+	return v.enqueueRecordedJob(job, func() error {
+		return v.runBatch(job, state, scope)
+	})
+}
+
+// runBatch calls start, execute for each chunk of the scope size and finish, each of them in a fresh limit context.
+// Without Database.Stateful, each method is called on a copy of the state when the batch is enqueued.
+// An exception in execute fails only the chunk, whose records are rolled back, and the first one is returned after finish.
+func (v *Interpreter) runBatch(job *RunningJob, state *ast.Object, scope int) error {
+	stateful := builtin.Implements(state.ClassType, builtin.StatefulType)
+	instance := func() *ast.Object {
+		if stateful {
+			return state
+		}
+		return copyObject(state, map[*ast.Object]*ast.Object{})
+	}
+	record := job.Record
+	context := builtin.NewBatchableContext(record.Id)
+
+	var records []*ast.Object
+	var listType *ast.ClassType
+	err := v.runAsyncJob(func() error {
+		locator, err := v.InvokeMethod(instance(), "start", []*ast.Object{context})
+		if err != nil {
+			return err
+		}
+		if builtin.IsQueryLocator(locator) && scope > builtin.MaxQueryLocatorBatchSize {
+			scope = builtin.MaxQueryLocatorBatchSize
+		}
+		records, listType, err = v.iterate(locator, batchableType(state.ClassType))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	record.TotalJobItems = (len(records) + scope - 1) / scope
+	if err := record.Update(); err != nil {
+		return err
+	}
+	var firstErr error
+	for i := 0; i < len(records); i += scope {
+		// System.abortJob stops the remaining chunks and finish
+		if record.Status == builtin.AsyncJobStatusAborted {
+			return nil
+		}
+		end := i + scope
+		if end > len(records) {
+			end = len(records)
+		}
+		chunk := ast.CreateObject(listType)
+		chunk.Extra["records"] = records[i:end]
+		release, err := v.setDmlSavepoint()
+		if err != nil {
+			return err
+		}
+		err = v.runAsyncJob(func() error {
+			_, err := v.InvokeMethod(instance(), "execute", []*ast.Object{context, chunk})
+			return err
+		})
+		if err := release(err != nil); err != nil {
+			return err
+		}
+		record.JobItemsProcessed++
+		if err != nil {
+			exception := raisedException(nil, err)
+			if exception == nil {
+				return err
+			}
+			record.AddError(exception)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		if err := record.Update(); err != nil {
+			return err
+		}
+	}
+
+	job.Finishing = true
+	err = v.runAsyncJob(func() error {
+		_, err := v.InvokeMethod(instance(), "finish", []*ast.Object{context})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	record.Status = builtin.AsyncJobStatusCompleted
+	return firstErr
+}
+
+// iterate returns the records of QueryLocator, List or Iterable returned by start of the batch,
+// and the type of the list passed to execute
+func (v *Interpreter) iterate(iterable *ast.Object, elementType *ast.ClassType) ([]*ast.Object, *ast.ClassType, error) {
+	if builtin.IsQueryLocator(iterable) {
+		return iterable.Extra["records"].([]*ast.Object), iterable.Extra["listType"].(*ast.ClassType), nil
+	}
+	if records, ok := iterable.Extra["records"].([]*ast.Object); ok {
+		return records, iterable.ClassType, nil
+	}
+	iterator, err := v.InvokeMethod(iterable, "iterator", []*ast.Object{})
+	if err != nil {
+		return nil, nil, err
+	}
+	records := []*ast.Object{}
+	for {
+		hasNext, err := v.InvokeMethod(iterator, "hasNext", []*ast.Object{})
+		if err != nil {
+			return nil, nil, err
+		}
+		if !hasNext.BoolValue() {
+			break
+		}
+		record, err := v.InvokeMethod(iterator, "next", []*ast.Object{})
+		if err != nil {
+			return nil, nil, err
+		}
+		records = append(records, record)
+	}
+	return records, builtin.CreateListType(elementType), nil
+}
+
+// batchableType returns the type parameter of Database.Batchable implemented by the class, SObject by default
+func batchableType(classType *ast.ClassType) *ast.ClassType {
+	if impl := builtin.ImplementedType(classType, builtin.BatchableType); impl != nil && len(impl.Generics) == 1 {
+		return impl.Generics[0]
+	}
+	return builtin.SObjectType
+}
+
+// copyObject copies the object deeply, the objects referred twice are copied once
+func copyObject(obj *ast.Object, copied map[*ast.Object]*ast.Object) *ast.Object {
+	if obj == nil || obj == builtin.Null {
+		return obj
+	}
+	if c, ok := copied[obj]; ok {
+		return c
+	}
+	c := &ast.Object{
+		ClassType:      obj.ClassType,
+		InstanceFields: ast.NewObjectMap(),
+		Final:          obj.Final,
+		Extra:          map[string]interface{}{},
+	}
+	copied[obj] = c
+	for key, value := range obj.Extra {
+		switch typedValue := value.(type) {
+		case []*ast.Object:
+			records := make([]*ast.Object, len(typedValue))
+			for i, record := range typedValue {
+				records[i] = copyObject(record, copied)
+			}
+			c.Extra[key] = records
+		case map[string]*ast.Object:
+			values := map[string]*ast.Object{}
+			for k, v := range typedValue {
+				values[k] = copyObject(v, copied)
+			}
+			c.Extra[key] = values
+		case map[string]struct{}:
+			values := map[string]struct{}{}
+			for k := range typedValue {
+				values[k] = struct{}{}
+			}
+			c.Extra[key] = values
+		default:
+			c.Extra[key] = value
+		}
+	}
+	if obj.InstanceFields != nil {
+		for key, value := range obj.InstanceFields.Data {
+			c.InstanceFields.Data[key] = copyObject(value, copied)
+		}
+	}
+	return c
+}
@@ -0,0 +1,110 @@
+package interpreter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// StackFrame is a user defined method or constructor being executed.
+// Line and Column point to the statement or expression executed in the frame.
+type StackFrame struct {
+	ClassName  string
+	MethodName string
+	IsTrigger  bool
+	FileName   string
+	Line       int
+	Column     int
+}
+
+func NewStackFrame(m *ast.Method) *StackFrame {
+	frame := &StackFrame{
+		MethodName: m.Name,
+	}
+	if m.IsConstructor {
+		frame.MethodName = "<init>"
+	}
+	if m.Parent != nil {
+		frame.ClassName = qualifiedClassName(m.Parent)
+		frame.IsTrigger = m.Parent.IsTrigger()
+	}
+	if m.Location != nil {
+		frame.FileName = m.Location.FileName
+		frame.Line = m.Location.Line
+		frame.Column = m.Location.Column
+	}
+	return frame
+}
+
+func (f *StackFrame) String() string {
+	if f.IsTrigger {
+		return fmt.Sprintf("Trigger.%s: line %d, column %d", f.ClassName, f.Line, f.Column)
+	}
+	return fmt.Sprintf("Class.%s.%s: line %d, column %d", f.ClassName, f.MethodName, f.Line, f.Column)
+}
+
+func qualifiedClassName(classType *ast.ClassType) string {
+	names := []string{classType.Name}
+	for parent := classType.Parent; parent != nil; {
+		decl, ok := parent.(*ast.ClassDeclaration)
+		if !ok {
+			break
+		}
+		names = append([]string{decl.Name}, names...)
+		parent = decl.Parent
+	}
+	return strings.Join(names, ".")
+}
+
+type CallStack struct {
+	Frames []*StackFrame
+}
+
+func NewCallStack() *CallStack {
+	return &CallStack{
+		Frames: []*StackFrame{},
+	}
+}
+
+func (s *CallStack) Push(f *StackFrame) {
+	s.Frames = append(s.Frames, f)
+}
+
+func (s *CallStack) Pop() *StackFrame {
+	if len(s.Frames) == 0 {
+		return nil
+	}
+	f := s.Frames[len(s.Frames)-1]
+	s.Frames = s.Frames[:len(s.Frames)-1]
+	return f
+}
+
+func (s *CallStack) Current() *StackFrame {
+	if len(s.Frames) == 0 {
+		return nil
+	}
+	return s.Frames[len(s.Frames)-1]
+}
+
+// SetLocation updates the executing position of the current frame
+func (s *CallStack) SetLocation(loc *ast.Location) {
+	f := s.Current()
+	if f == nil || loc == nil {
+		return
+	}
+	f.Line = loc.Line
+	f.Column = loc.Column
+	if loc.FileName != "" {
+		f.FileName = loc.FileName
+	}
+}
+
+// String returns Apex style stack trace, the innermost frame comes first
+func (s *CallStack) String() string {
+	lines := make([]string, len(s.Frames))
+	for i, f := range s.Frames {
+		lines[len(s.Frames)-1-i] = f.String()
+	}
+	return strings.Join(lines, "\n")
+}
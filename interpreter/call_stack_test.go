@@ -0,0 +1,44 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+func TestCallStack(t *testing.T) {
+	foo := &ast.ClassType{Name: "Foo"}
+	inner := &ast.ClassType{
+		Name:   "Inner",
+		Parent: &ast.ClassDeclaration{Name: "Foo"},
+	}
+	stack := NewCallStack()
+	stack.Push(NewStackFrame(&ast.Method{
+		Name:     "main",
+		Parent:   foo,
+		Location: &ast.Location{FileName: "foo.cls", Line: 2, Column: 4},
+	}))
+	stack.SetLocation(&ast.Location{Line: 3, Column: 8})
+	stack.Push(NewStackFrame(&ast.Method{
+		Name:          "Inner",
+		Parent:        inner,
+		IsConstructor: true,
+		Location:      &ast.Location{FileName: "foo.cls", Line: 10, Column: 4},
+	}))
+	stack.SetLocation(&ast.Location{Line: 11, Column: 12})
+
+	expected := `Class.Foo.Inner.<init>: line 11, column 12
+Class.Foo.main: line 3, column 8`
+	if actual := stack.String(); actual != expected {
+		t.Errorf("expected %s, actual %s", expected, actual)
+	}
+
+	stack.Pop()
+	if f := stack.Current(); f.MethodName != "main" || f.FileName != "foo.cls" {
+		t.Errorf("unexpected current frame %v", f)
+	}
+	stack.Pop()
+	if f := stack.Pop(); f != nil {
+		t.Errorf("expected nil, actual %v", f)
+	}
+}
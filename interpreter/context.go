@@ -15,6 +15,25 @@ type Context struct {
 
 	CurrentMethod *ast.MethodDeclaration
 	CurrentClass  *ast.ClassType
+	CallStack     *CallStack
+	TriggerDepth  int
+	Limits        *builtin.Limits
+	Statements    int // executed statements, used for sampling heap size
+	AsyncJobs     []AsyncJob
+	AsyncJobCount int                              // enqueued jobs, used for numbering the job Ids
+	AsyncApexJobs map[string]*builtin.AsyncApexJob // recorded jobs by Id, used by System.abortJob
+	RunningJob    *RunningJob                      // nil in synchronous execution
+
+	ScheduledJobs     []*ScheduledJob
+	ScheduledJobCount int // scheduled jobs, used for numbering the CronTrigger Ids
+
+	Savepoints     []string // valid savepoints of the transaction in the order of setting
+	SavepointCount int      // set savepoints, used for naming the savepoints of the storage
+
+	IsRunningTest bool
+	SeeAllData    bool // the test can see the records saved before the test by @isTest(SeeAllData=true)
+	TestStarted   bool
+	TestLimits    *builtin.Limits // limits before Test.startTest
 }
 
 func NewContext() *Context {
@@ -23,6 +42,9 @@ func NewContext() *Context {
 	ctx.ClassTypes = ast.NewClassMap()
 	ctx.NameSpaces = builtin.NewNameSpaceStore()
 	ctx.Env = NewEnv(nil)
+	ctx.CallStack = NewCallStack()
+	ctx.Limits = builtin.NewLimits(builtin.SyncLimitThresholds)
+	ctx.AsyncApexJobs = map[string]*builtin.AsyncApexJob{}
 	return ctx
 }
 
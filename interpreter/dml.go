@@ -0,0 +1,493 @@
+package interpreter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+)
+
+const maxTriggerDepth = 16
+
+// ExecuteDml saves the records firing before and after triggers of the SObject, and returns the list of the results of the records.
+// If allOrNone is true, the dml fails with DmlException when any record fails and none of the records are saved.
+// Otherwise the records failed are returned with the errors, and the others are saved.
+func (v *Interpreter) ExecuteDml(dmlType string, records []*ast.Object, upsertKey string, allOrNone bool) (*ast.Object, error) {
+	if exception := v.Context.Limits.AddDml(len(records)); exception != nil {
+		return nil, builtin.NewRaiseError(exception)
+	}
+	var results []*dmlResult
+	var err error
+	if dmlType == "upsert" {
+		results, err = v.executeUpsert(records, upsertKey, allOrNone)
+	} else {
+		results, err = v.executeDml(dmlType, records, allOrNone)
+	}
+	if err != nil {
+		return nil, dmlError(err)
+	}
+	objects := make([]*ast.Object, len(results))
+	failures := []*builtin.DmlFailure{}
+	for i, result := range results {
+		id, ok := result.record.InstanceFields.Get("Id")
+		if !ok {
+			id = builtin.Null
+		}
+		objects[i] = builtin.NewDmlResult(dmlType, id, result.created, result.errors)
+		if len(result.errors) > 0 {
+			failures = append(failures, &builtin.DmlFailure{Index: i, Id: id, Errors: result.errors})
+		}
+	}
+	if allOrNone && len(failures) > 0 {
+		return nil, builtin.NewRaiseError(builtin.NewDmlException(dmlType, failures))
+	}
+	return builtin.NewDmlResultList(dmlType, objects), nil
+}
+
+// dmlError raises the error of the storage as DmlException, so that it can be caught.
+// The exceptions raised in the triggers are raised as they are.
+func dmlError(err error) error {
+	if _, ok := err.(*builtin.RaiseError); ok {
+		return err
+	}
+	return builtin.NewRaiseError(builtin.NewException(builtin.DmlExceptionType, err.Error()))
+}
+
+// dmlResult is the result of the record in DML, the record is saved if it has no errors
+type dmlResult struct {
+	record  *ast.Object
+	created bool
+	errors  []*builtin.DmlError
+}
+
+func hasErrors(results []*dmlResult) bool {
+	for _, result := range results {
+		if len(result.errors) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// executeDml saves the records without errors until all of them are saved.
+// If the triggers add errors to the records, the records saved are rolled back and saved again without the records failed.
+// If allOrNone is true, the records are not saved if any record fails.
+func (v *Interpreter) executeDml(dmlType string, records []*ast.Object, allOrNone bool) ([]*dmlResult, error) {
+	results := make([]*dmlResult, len(records))
+	for i, record := range records {
+		results[i] = &dmlResult{record: record, created: dmlType == "insert"}
+	}
+	if len(records) == 0 {
+		return results, nil
+	}
+	sObjectType := records[0].ClassType.Name
+	if err := v.checkIds(dmlType, sObjectType, results); err != nil {
+		return nil, err
+	}
+	for !(allOrNone && hasErrors(results)) {
+		saving := []*dmlResult{}
+		for _, result := range results {
+			if len(result.errors) == 0 {
+				saving = append(saving, result)
+			}
+		}
+		if len(saving) == 0 {
+			break
+		}
+		release, err := v.setDmlSavepoint()
+		if err != nil {
+			return nil, err
+		}
+		failed, err := v.saveRecords(dmlType, sObjectType, saving)
+		if err == nil && !failed {
+			return results, release(false)
+		}
+		if err := release(true); err != nil {
+			return nil, err
+		}
+		if dmlType == "insert" {
+			for _, result := range saving {
+				result.record.InstanceFields.Set("Id", builtin.Null)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// checkIds adds the errors to the records whose Ids are invalid for the dml,
+// such as the record inserted again after Database.rollback and the record updated after deleted
+func (v *Interpreter) checkIds(dmlType, sObjectType string, results []*dmlResult) error {
+	ids := []string{}
+	for _, result := range results {
+		id, ok := result.record.InstanceFields.Get("Id")
+		switch {
+		case dmlType == "insert" && ok && id != builtin.Null:
+			result.errors = append(result.errors, &builtin.DmlError{
+				StatusCode: "INVALID_FIELD_FOR_INSERT_UPDATE",
+				Message:    "cannot specify Id in an insert call",
+				Fields:     []string{"Id"},
+			})
+		case (dmlType == "update" || dmlType == "delete") && (!ok || id == builtin.Null):
+			result.errors = append(result.errors, &builtin.DmlError{
+				StatusCode: "MISSING_ARGUMENT",
+				Message:    fmt.Sprintf("Id not specified in %s call", withArticle(dmlType)),
+				Fields:     []string{},
+			})
+		case dmlType == "update" || dmlType == "delete":
+			ids = append(ids, id.StringValue())
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	records, err := builtin.DatabaseDriver.FindRecords(sObjectType, "Id", ids)
+	if err != nil {
+		return err
+	}
+	saved := map[string]bool{}
+	for _, record := range records {
+		id, _ := record.InstanceFields.Get("Id")
+		saved[id.StringValue()] = true
+	}
+	for _, result := range results {
+		id, ok := result.record.InstanceFields.Get("Id")
+		if ok && id != builtin.Null && !saved[id.StringValue()] {
+			result.errors = append(result.errors, &builtin.DmlError{
+				StatusCode: "ENTITY_IS_DELETED",
+				Message:    "entity is deleted",
+				Fields:     []string{},
+			})
+		}
+	}
+	return nil
+}
+
+// withArticle returns the DML type with the indefinite article, such as an update and a delete
+func withArticle(dmlType string) string {
+	if strings.ContainsAny(dmlType[:1], "aeiou") {
+		return "an " + dmlType
+	}
+	return "a " + dmlType
+}
+
+// saveRecords saves the records firing the triggers, and returns whether any record failed.
+// The errors added by the triggers and the errors of the validation are added to the results.
+func (v *Interpreter) saveRecords(dmlType, sObjectType string, results []*dmlResult) (bool, error) {
+	records := make([]*ast.Object, len(results))
+	for i, result := range results {
+		records[i] = result.record
+		delete(result.record.Extra, "errors")
+	}
+
+	var newRecords, oldRecords []*ast.Object
+	var err error
+	switch dmlType {
+	case "insert", "undelete":
+		newRecords = records
+	case "update":
+		newRecords = records
+		oldRecords, err = v.findOldRecords(sObjectType, records)
+	case "delete":
+		oldRecords, err = v.findOldRecords(sObjectType, records)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if err := v.fireTriggers("before", dmlType, sObjectType, newRecords, oldRecords); err != nil {
+		return false, err
+	}
+	failed := addRecordErrors(results, newRecords, oldRecords)
+	validationErrors, err := builtin.ValidateRecords(dmlType, sObjectType, records)
+	if err != nil {
+		return false, err
+	}
+	for i, errors := range validationErrors {
+		if len(errors) > 0 {
+			results[i].errors = append(results[i].errors, errors...)
+			failed = true
+		}
+	}
+	if failed {
+		return true, nil
+	}
+	if _, err := builtin.DatabaseDriver.Execute(dmlType, sObjectType, records, ""); err != nil {
+		return false, err
+	}
+	if err := v.fireTriggers("after", dmlType, sObjectType, newRecords, oldRecords); err != nil {
+		return false, err
+	}
+	return addRecordErrors(results, newRecords, oldRecords), nil
+}
+
+// addRecordErrors adds the errors added by SObject#addError in the triggers to the results,
+// which are added to Trigger.old on delete
+func addRecordErrors(results []*dmlResult, newRecords, oldRecords []*ast.Object) bool {
+	records := newRecords
+	if records == nil {
+		records = oldRecords
+	}
+	failed := false
+	for i, record := range records {
+		for _, message := range builtin.RecordErrors(record) {
+			results[i].errors = append(results[i].errors, &builtin.DmlError{
+				StatusCode: "FIELD_CUSTOM_VALIDATION_EXCEPTION",
+				Message:    message,
+				Fields:     []string{},
+			})
+			failed = true
+		}
+	}
+	return failed
+}
+
+// executeUpsert updates the records which exist by Id or the external key, and inserts the others.
+// The records of the same key and the records matching more than one record fail.
+func (v *Interpreter) executeUpsert(records []*ast.Object, upsertKey string, allOrNone bool) ([]*dmlResult, error) {
+	results := make([]*dmlResult, len(records))
+	for i, record := range records {
+		results[i] = &dmlResult{record: record}
+	}
+	if len(records) == 0 {
+		return results, nil
+	}
+	sObjectType := records[0].ClassType.Name
+	key := upsertKey
+	if key == "" {
+		key = "Id"
+	}
+	values := []string{}
+	for _, record := range records {
+		if value, ok := record.InstanceFields.Get(key); ok && value != builtin.Null {
+			values = append(values, value.StringValue())
+		}
+	}
+	saved, err := builtin.DatabaseDriver.FindRecords(sObjectType, key, values)
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string][]*ast.Object{}
+	for _, record := range saved {
+		value, _ := record.InstanceFields.Get(key)
+		existing[value.StringValue()] = append(existing[value.StringValue()], record)
+	}
+
+	inserts := []*dmlResult{}
+	updates := []*dmlResult{}
+	seen := map[string]bool{}
+	for _, result := range results {
+		value, ok := result.record.InstanceFields.Get(key)
+		if !ok || value == builtin.Null {
+			inserts = append(inserts, result)
+			continue
+		}
+		if seen[value.StringValue()] {
+			result.errors = append(result.errors, duplicateKeyError(key, value.StringValue()))
+			continue
+		}
+		seen[value.StringValue()] = true
+		found := existing[value.StringValue()]
+		switch {
+		case len(found) == 1:
+			id, _ := found[0].InstanceFields.Get("Id")
+			result.record.InstanceFields.Set("Id", id)
+			updates = append(updates, result)
+		case len(found) > 1:
+			ids := make([]string, len(found))
+			for i, record := range found {
+				id, _ := record.InstanceFields.Get("Id")
+				ids[i] = id.StringValue()
+			}
+			result.errors = append(result.errors, &builtin.DmlError{
+				StatusCode: "DUPLICATE_EXTERNAL_ID",
+				Message:    fmt.Sprintf("%s: more than one record found for external id field: [%s]", key, strings.Join(ids, ", ")),
+				Fields:     []string{key},
+			})
+		case key == "Id":
+			result.errors = append(result.errors, &builtin.DmlError{
+				StatusCode: "INVALID_CROSS_REFERENCE_KEY",
+				Message:    "invalid cross reference id",
+				Fields:     []string{},
+			})
+		default:
+			inserts = append(inserts, result)
+		}
+	}
+	if allOrNone && hasErrors(results) {
+		return results, nil
+	}
+
+	release, err := v.setDmlSavepoint()
+	if err != nil {
+		return nil, err
+	}
+	for _, dml := range []struct {
+		dmlType string
+		results []*dmlResult
+	}{
+		{"insert", inserts},
+		{"update", updates},
+	} {
+		records := make([]*ast.Object, len(dml.results))
+		for i, result := range dml.results {
+			result.created = dml.dmlType == "insert"
+			records[i] = result.record
+		}
+		saved, err := v.executeDml(dml.dmlType, records, allOrNone)
+		if err == nil {
+			for i, result := range saved {
+				dml.results[i].errors = result.errors
+			}
+		}
+		if err != nil || (allOrNone && hasErrors(saved)) {
+			if err := release(true); err != nil {
+				return nil, err
+			}
+			for _, result := range inserts {
+				result.record.InstanceFields.Set("Id", builtin.Null)
+			}
+			return results, err
+		}
+	}
+	return results, release(false)
+}
+
+// duplicateKeyError returns the error of the record whose key is the same as the previous record in upsert
+func duplicateKeyError(key, value string) *builtin.DmlError {
+	if key == "Id" {
+		return &builtin.DmlError{
+			StatusCode: "DUPLICATE_VALUE",
+			Message:    fmt.Sprintf("Duplicate id in list: %s", value),
+			Fields:     []string{},
+		}
+	}
+	return &builtin.DmlError{
+		StatusCode: "DUPLICATE_EXTERNAL_ID",
+		Message:    fmt.Sprintf("Duplicate external id specified: %s", value),
+		Fields:     []string{key},
+	}
+}
+
+// findOldRecords returns the saved records in the order of records,
+// the record itself is used if it is not saved
+func (v *Interpreter) findOldRecords(sObjectType string, records []*ast.Object) ([]*ast.Object, error) {
+	ids := []string{}
+	for _, record := range records {
+		if id, ok := record.InstanceFields.Get("Id"); ok && id != builtin.Null {
+			ids = append(ids, id.StringValue())
+		}
+	}
+	found, err := builtin.DatabaseDriver.FindRecords(sObjectType, "Id", ids)
+	if err != nil {
+		return nil, err
+	}
+	saved := map[string]*ast.Object{}
+	for _, record := range found {
+		id, _ := record.InstanceFields.Get("Id")
+		saved[id.StringValue()] = record
+	}
+	oldRecords := make([]*ast.Object, len(records))
+	for i, record := range records {
+		oldRecords[i] = record
+		if id, ok := record.InstanceFields.Get("Id"); ok && id != builtin.Null {
+			if old, ok := saved[id.StringValue()]; ok {
+				oldRecords[i] = old
+			}
+		}
+	}
+	return oldRecords, nil
+}
+
+func (v *Interpreter) fireTriggers(timing, dmlType, sObjectType string, newRecords, oldRecords []*ast.Object) error {
+	triggers := v.findTriggers(timing, dmlType, sObjectType)
+	if len(triggers) == 0 {
+		return nil
+	}
+	if v.Context.TriggerDepth >= maxTriggerDepth {
+		return builtin.NewRaiseError(builtin.NewException(builtin.DmlExceptionType, "maximum trigger depth exceeded"))
+	}
+	v.Context.TriggerDepth++
+	defer func() {
+		v.Context.TriggerDepth--
+	}()
+
+	for _, trigger := range triggers {
+		for _, methods := range trigger.StaticMethods.All() {
+			for _, m := range methods {
+				context := builtin.NewTriggerContext(m.Parameters[0].Type, timing, dmlType, newRecords, oldRecords)
+				if err := v.invokeTrigger(trigger, m, context); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// findTriggers returns the triggers on the SObject for the timing in the order of name
+func (v *Interpreter) findTriggers(timing, dmlType, sObjectType string) []*ast.ClassType {
+	triggers := []*ast.ClassType{}
+	for _, classType := range v.Context.ClassTypes.Data {
+		if !classType.IsTrigger() {
+			continue
+		}
+		if !strings.EqualFold(classType.Trigger.Object, sObjectType) {
+			continue
+		}
+		if classType.Trigger.HasTiming(timing, dmlType) {
+			triggers = append(triggers, classType)
+		}
+	}
+	sort.Slice(triggers, func(i, j int) bool {
+		return triggers[i].Name < triggers[j].Name
+	})
+	return triggers
+}
+
+// invokeTrigger executes the body of trigger, Trigger refers to the context
+// in the trigger and in the classes called from the trigger.
+// The previous context is restored after the trigger, or cleared if there is none.
+func (v *Interpreter) invokeTrigger(trigger *ast.ClassType, m *ast.Method, context *ast.Object) error {
+	prevEnv := v.Context.Env
+	prevClass := v.Context.CurrentClass
+	prevTrigger, hasTrigger := v.Context.StaticField.Get("_", "Trigger")
+	v.Context.Env = NewEnv(nil)
+	v.Context.Env.Define(m.Parameters[0].Name, context)
+	v.Context.CurrentClass = trigger
+	v.Context.StaticField.Set("_", "Trigger", context.InstanceFields)
+	defer func() {
+		v.Context.Env = prevEnv
+		v.Context.CurrentClass = prevClass
+		if !hasTrigger {
+			prevTrigger = v.inactiveTriggerContext()
+		}
+		v.Context.StaticField.Set("_", "Trigger", prevTrigger)
+	}()
+
+	v.Context.CallStack.Push(NewStackFrame(m))
+	r, err := m.Statements.Accept(v)
+	v.Context.CallStack.Pop()
+	if exception := raisedException(r, err); exception != nil {
+		return builtin.NewRaiseError(exception)
+	}
+	return err
+}
+
+// inactiveTriggerContext returns the static fields of Trigger outside of trigger,
+// which are false, 0 and null as initialized by LoadStaticField
+func (v *Interpreter) inactiveTriggerContext() *ast.ObjectMap {
+	fields := ast.NewObjectMap()
+	for _, f := range builtin.TriggerType.StaticFields.Data {
+		value, err := f.Expression.Accept(v)
+		if err != nil {
+			panic(err)
+		}
+		fields.Set(f.Name, value.(*ast.Object))
+	}
+	return fields
+}
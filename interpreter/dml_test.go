@@ -0,0 +1,40 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+)
+
+func TestInvokeTriggerClearsContext(t *testing.T) {
+	interpreter := NewInterpreter(ast.NewClassMap())
+	trigger := &ast.ClassType{Name: "AccountTrigger"}
+	method := &ast.Method{
+		Name:       "AccountTrigger",
+		Parameters: []*ast.Parameter{{Name: "Trigger"}},
+		Statements: &ast.Block{},
+	}
+	contextType := builtin.CreateTriggerContextType(builtin.SObjectType)
+	record := ast.CreateObject(builtin.SObjectType)
+	context := builtin.NewTriggerContext(contextType, "before", "insert", []*ast.Object{record}, nil)
+	if err := interpreter.invokeTrigger(trigger, method, context); err != nil {
+		t.Fatal(err)
+	}
+
+	fields, ok := interpreter.Context.StaticField.Get("_", "Trigger")
+	if !ok {
+		t.Fatal("expected Trigger static fields")
+	}
+	for _, name := range []string{"isExecuting", "isInsert", "isBefore"} {
+		if value, _ := fields.Get(name); value == nil || value.BoolValue() {
+			t.Errorf("expected Trigger.%s to be false after the trigger", name)
+		}
+	}
+	if value, _ := fields.Get("new"); value != builtin.Null {
+		t.Errorf("expected Trigger.new to be null after the trigger, actual %v", value)
+	}
+	if value, _ := fields.Get("size"); value == nil || value.IntegerValue() != 0 {
+		t.Errorf("expected Trigger.size to be 0 after the trigger")
+	}
+}
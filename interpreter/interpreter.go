@@ -7,7 +7,6 @@ import (
 
 	"strings"
 
-	"errors"
 	"fmt"
 
 	"github.com/k0kubun/pp"
@@ -162,7 +161,13 @@ func (v *Interpreter) LoadStaticField() {
 	v.Context.StaticField = NewStaticFieldMap()
 	for className, classType := range v.Context.ClassTypes.Data {
 		objectMap := ast.NewObjectMap()
-		if classType.StaticFields != nil {
+		if classType.IsEnum() {
+			for _, value := range builtin.EnumValues(classType) {
+				objectMap.Set(value.Extra["name"].(string), value)
+			}
+		} else if classType.SuperClass == builtin.SObjectType {
+			objectMap.Set("SObjectType", builtin.NewSObjectTypeObject(classType.Name))
+		} else if classType.StaticFields != nil {
 			for _, f := range classType.StaticFields.Data {
 				val, err := f.Expression.Accept(v)
 				if err != nil {
@@ -191,6 +196,10 @@ func (v *Interpreter) VisitInterfaceDeclaration(n *ast.InterfaceDeclaration) (in
 	panic("not pass")
 }
 
+func (v *Interpreter) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	panic("not pass")
+}
+
 func (v *Interpreter) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
 	return builtin.NewInteger(n.Value), nil
 }
@@ -210,15 +219,26 @@ func (v *Interpreter) VisitArrayAccess(n *ast.ArrayAccess) (interface{}, error)
 	}
 	receiver := r.(*ast.Object)
 	key := k.(*ast.Object)
+	if receiver == builtin.Null {
+		return nil, v.raiseSystemException(builtin.NullPointerExceptionType, n, "Attempt to de-reference a null object")
+	}
 	if receiver.ClassType.Name == "List" {
 		records := receiver.Extra["records"].([]*ast.Object)
-		return records[key.IntegerValue()], nil
+		index := key.IntegerValue()
+		if index < 0 || index >= len(records) {
+			return nil, v.listIndexOutOfBounds(n, index)
+		}
+		return records[index], nil
 	}
 
 	records := receiver.Extra["values"].(map[string]*ast.Object)
 	return records[key.StringValue()], nil
 }
 
+func (v *Interpreter) listIndexOutOfBounds(n ast.Node, index int) error {
+	return v.raiseSystemException(builtin.ListExceptionType, n, fmt.Sprintf("List index out of bounds: %d", index))
+}
+
 func (v *Interpreter) VisitBooleanLiteral(n *ast.BooleanLiteral) (interface{}, error) {
 	return builtin.NewBoolean(n.Value), nil
 }
@@ -244,9 +264,11 @@ func (v *Interpreter) VisitDml(n *ast.Dml) (interface{}, error) {
 	} else {
 		records = []*ast.Object{obj}
 	}
-	sObjectType := records[0].ClassType.Name
-	builtin.DatabaseDriver.Execute(n.Type, sObjectType, records, n.UpsertKey)
-	return nil, nil
+	_, err = v.ExecuteDml(n.Type, records, n.UpsertKey, true)
+	if exception := raisedException(nil, err); exception != nil {
+		v.setStackTrace(exception, n)
+	}
+	return nil, err
 }
 
 func (v *Interpreter) VisitDoubleLiteral(n *ast.DoubleLiteral) (interface{}, error) {
@@ -259,34 +281,77 @@ func (v *Interpreter) VisitFieldDeclaration(n *ast.FieldDeclaration) (interface{
 
 func (v *Interpreter) VisitTry(n *ast.Try) (interface{}, error) {
 	res, err := n.Block.Accept(v)
-	if err != nil {
-		return nil, err
-	}
-	if res != nil {
-		switch obj := res.(*ast.Object); obj.ClassType {
-		case builtin.ReturnType, builtin.BreakType, builtin.ContinueType:
-			return obj, nil
-		case builtin.RaiseType:
-			// TODO: implement
-			for _, catch := range n.CatchClause {
-				raiseValue := obj.Value().(*ast.Object)
-				if builtin.Equals(catch.Type, raiseValue.ClassType) {
-					v.Context.Env.Define(catch.Identifier, raiseValue)
-					_, err := catch.Accept(v)
-					if err != nil {
-						return nil, err
-					}
-					// TODO: return, raise impl
-				}
+	if exception := raisedException(res, err); exception != nil {
+		// LimitException can not be caught, and finally block is not executed
+		if builtin.IsLimitException(exception) {
+			return res, err
+		}
+		for _, catch := range n.CatchClause {
+			if !builtin.Equals(catch.Type, exception.ClassType) {
+				continue
 			}
+			res, err = v.NewEnv(func() (interface{}, error) {
+				v.Context.Env.Define(catch.Identifier, exception)
+				return catch.Accept(v)
+			})
+			break
 		}
 	}
 	if n.FinallyBlock != nil {
-		if _, err = n.FinallyBlock.Accept(v); err != nil {
-			return nil, err
+		finallyRes, finallyErr := n.FinallyBlock.Accept(v)
+		if finallyErr != nil {
+			return nil, finallyErr
+		}
+		// return, break, continue and throw in finally block take precedence
+		if finallyRes != nil {
+			return finallyRes, nil
 		}
 	}
-	return nil, nil
+	return res, err
+}
+
+// raisedException returns the thrown exception object from the result of statement,
+// whether it is raised by throw statement or propagated from method invocation.
+func raisedException(res interface{}, err error) *ast.Object {
+	if err != nil {
+		if raise, ok := err.(*builtin.RaiseError); ok {
+			return raise.Exception
+		}
+		return nil
+	}
+	if obj, ok := res.(*ast.Object); ok && obj.ClassType == builtin.RaiseType {
+		return obj.Value().(*ast.Object)
+	}
+	return nil
+}
+
+// raiseSystemException creates a catchable error of the built-in exception
+// raised on the line of the node
+func (v *Interpreter) raiseSystemException(classType *ast.ClassType, n ast.Node, message string) error {
+	exception := builtin.NewException(classType, message)
+	v.setStackTrace(exception, n)
+	return builtin.NewRaiseError(exception)
+}
+
+// setStackTrace records the line number and the call stack where the exception is thrown.
+// A rethrown exception keeps the stack trace of the first throw.
+func (v *Interpreter) setStackTrace(exception *ast.Object, n ast.Node) {
+	if _, ok := exception.Extra["stackTrace"]; ok {
+		return
+	}
+	v.Context.CallStack.SetLocation(n.GetLocation())
+	if location := n.GetLocation(); location != nil {
+		exception.Extra["lineNumber"] = location.Line
+	}
+	exception.Extra["stackTrace"] = v.Context.CallStack.String()
+}
+
+func (v *Interpreter) nullPointerException(n ast.Node, npe *builtin.NullPointerException) error {
+	return v.raiseSystemException(
+		builtin.NullPointerExceptionType,
+		n,
+		fmt.Sprintf("Attempt to de-reference a null object: %s", npe.GetName()),
+	)
 }
 
 func (v *Interpreter) VisitCatch(n *ast.Catch) (interface{}, error) {
@@ -314,6 +379,9 @@ func (v *Interpreter) VisitFor(n *ast.For) (interface{}, error) {
 				}
 				if res.(*ast.Object).BoolValue() {
 					res, err = n.Statements.Accept(v)
+					if err != nil {
+						return nil, err
+					}
 					if res != nil {
 						switch obj := res.(*ast.Object); obj.ClassType {
 						case builtin.BreakType:
@@ -412,8 +480,7 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 		_, m, err = FindInstanceMethod(receiver.(*ast.Object), exp.FieldName, evaluated, compiler.MODIFIER_ALL_OK)
 		if err != nil {
 			if npe, ok := err.(*builtin.NullPointerException); ok {
-				location := n.Location
-				return nil, fmt.Errorf("null pointer exception: %s at %d:%d", npe.GetName(), location.Line, location.Column)
+				return nil, v.nullPointerException(n, npe)
 			}
 			return nil, err
 		}
@@ -434,12 +501,42 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 		receiver, m, err = resolver.ResolveMethod(exp.Value, evaluated)
 		if err != nil {
 			if npe, ok := err.(*builtin.NullPointerException); ok {
-				location := n.Location
-				return nil, fmt.Errorf("null pointer exception: %s at %d:%d", npe.GetName(), location.Line, location.Column)
+				return nil, v.nullPointerException(n, npe)
 			}
 			return nil, err
 		}
 	}
+	return v.invokeMethod(n, receiver, m, evaluated)
+}
+
+// InvokeMethod invokes the instance method of the object from native functions,
+// such as HttpCalloutMock#respond called in Http#send
+func (v *Interpreter) InvokeMethod(receiver *ast.Object, methodName string, parameters []*ast.Object) (*ast.Object, error) {
+	_, m, err := FindInstanceMethod(receiver, methodName, parameters, compiler.MODIFIER_ALL_OK)
+	if err != nil {
+		return nil, err
+	}
+	n, _ := v.Extra["node"].(ast.Node)
+	r, err := v.invokeMethod(n, receiver, m, parameters)
+	if err != nil {
+		return nil, err
+	}
+	if obj, ok := r.(*ast.Object); ok {
+		return obj, nil
+	}
+	return builtin.Null, nil
+}
+
+// invokeMethod executes the method on the receiver, which is an object or a class for static method.
+// The method annotated with @future is queued instead.
+func (v *Interpreter) invokeMethod(n ast.Node, receiver interface{}, m *ast.Method, evaluated []*ast.Object) (interface{}, error) {
+	if m.IsAnnotated("future") {
+		return nil, v.enqueueFutureMethod(n, receiver, m, evaluated)
+	}
+	return v.callMethod(n, receiver, m, evaluated)
+}
+
+func (v *Interpreter) callMethod(n ast.Node, receiver interface{}, m *ast.Method, evaluated []*ast.Object) (interface{}, error) {
 	prevClass := v.Context.CurrentClass
 	switch typedReceiver := receiver.(type) {
 	case *ast.Object:
@@ -453,6 +550,7 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 
 	if m.NativeFunction != nil {
 		var r interface{}
+		prevNode := v.Extra["node"]
 		v.Extra["node"] = n
 		switch typedReceiver := receiver.(type) {
 		case *ast.Object:
@@ -460,12 +558,20 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 		case *ast.ClassType:
 			r = m.NativeFunction(nil, evaluated, v.Extra)
 		}
-		v.Extra["node"] = nil
+		v.Extra["node"] = prevNode
 		Publish("method_end", v.Context, n)
+		if obj, ok := r.(*ast.Object); ok && obj.ClassType == builtin.RaiseType {
+			exception := obj.Value().(*ast.Object)
+			v.setStackTrace(exception, n)
+			return nil, builtin.NewRaiseError(exception)
+		}
 		return r, nil
 	}
 	prev := v.Context.Env
 	v.Context.Env = NewEnv(nil)
+	defer func() {
+		v.Context.Env = prev
+	}()
 	for i, param := range m.Parameters {
 		v.Context.Env.Define(param.Name, evaluated[i])
 	}
@@ -473,12 +579,16 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 	case *ast.Object:
 		v.Context.Env.Define("this", obj)
 	}
+	if n != nil {
+		v.Context.CallStack.SetLocation(n.GetLocation())
+	}
+	v.Context.CallStack.Push(NewStackFrame(m))
 	r, err := m.Statements.Accept(v)
+	v.Context.CallStack.Pop()
 	Publish("method_end", v.Context, n)
 	if err != nil {
 		return nil, err
 	}
-	v.Context.Env = prev
 
 	if r != nil {
 		obj := r.(*ast.Object)
@@ -486,7 +596,7 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 		case builtin.ReturnType:
 			return obj.Value(), nil
 		case builtin.RaiseType:
-			return obj, nil
+			return nil, builtin.NewRaiseError(obj.Value().(*ast.Object))
 		}
 	}
 	return nil, nil
@@ -537,8 +647,17 @@ func (v *Interpreter) VisitNew(n *ast.New) (interface{}, error) {
 				v.Context.Env.Define(param.Name, evaluated[i])
 			}
 			v.Context.Env.Define("this", newObj)
-			constructor.Statements.Accept(v)
+			v.Context.CallStack.SetLocation(n.Location)
+			v.Context.CallStack.Push(NewStackFrame(constructor))
+			r, err := constructor.Statements.Accept(v)
+			v.Context.CallStack.Pop()
 			v.Context.Env = prev
+			if err != nil {
+				return nil, err
+			}
+			if obj, ok := r.(*ast.Object); ok && obj.ClassType == builtin.RaiseType {
+				return nil, builtin.NewRaiseError(obj.Value().(*ast.Object))
+			}
 		}
 	}
 
@@ -556,7 +675,7 @@ func (v *Interpreter) VisitNew(n *ast.New) (interface{}, error) {
 			if err != nil {
 				return nil, err
 			}
-			newObj.InstanceFields.Set(name.Value[0], value.(*ast.Object))
+			builtin.SetField(newObj, name.Value[0], value.(*ast.Object))
 		}
 	}
 
@@ -624,13 +743,34 @@ func (v *Interpreter) assignValue(exp ast.Node, newValue *ast.Object) error {
 	switch t := exp.(type) {
 	case *ast.Name:
 		resolver := NewTypeResolver(v.Context)
+		if len(t.Value) > 1 {
+			receiver, err := resolver.ResolveVariable(t.Value[:len(t.Value)-1])
+			if err == nil && receiver != builtin.Null && isIdField(receiver, t.Value[len(t.Value)-1]) {
+				id, err := v.convertToId(t, newValue)
+				if err != nil {
+					return err
+				}
+				newValue = id
+			}
+		}
 		resolver.SetVariable(t.Value, newValue)
 	case *ast.FieldAccess:
 		exp, err := t.Expression.Accept(v)
 		if err != nil {
 			return err
 		}
-		exp.(*ast.Object).InstanceFields.Set(t.FieldName, newValue)
+		receiver := exp.(*ast.Object)
+		if receiver == builtin.Null {
+			return v.raiseSystemException(builtin.NullPointerExceptionType, t, "Attempt to de-reference a null object")
+		}
+		if isIdField(receiver, t.FieldName) {
+			id, err := v.convertToId(t, newValue)
+			if err != nil {
+				return err
+			}
+			newValue = id
+		}
+		builtin.SetField(receiver, t.FieldName, newValue)
 	case *ast.ArrayAccess:
 		k, err := t.Key.Accept(v)
 		if err != nil {
@@ -642,8 +782,16 @@ func (v *Interpreter) assignValue(exp ast.Node, newValue *ast.Object) error {
 			return err
 		}
 		receiver := r.(*ast.Object)
+		if receiver == builtin.Null {
+			return v.raiseSystemException(builtin.NullPointerExceptionType, t, "Attempt to de-reference a null object")
+		}
 		if receiver.ClassType.Name == "List" {
-			receiver.Extra["records"].([]*ast.Object)[key.IntegerValue()] = newValue
+			records := receiver.Extra["records"].([]*ast.Object)
+			index := key.IntegerValue()
+			if index < 0 || index >= len(records) {
+				return v.listIndexOutOfBounds(t, index)
+			}
+			records[index] = newValue
 		}
 		if receiver.ClassType.Name == "Map" {
 			receiver.Extra["values"].(map[string]*ast.Object)[key.StringValue()] = newValue
@@ -705,6 +853,15 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 		rType = rObj.ClassType
 	}
 
+	switch n.Op {
+	case "+", "-", "*", "/", "<", ">", "<=", ">=":
+		isNullOperand := lObj == builtin.Null || rObj == builtin.Null
+		isConcatenation := n.Op == "+" && (isStringType(lType) || isStringType(rType))
+		if isNullOperand && !isConcatenation {
+			return nil, v.raiseSystemException(builtin.NullPointerExceptionType, n, "Attempt to de-reference a null object")
+		}
+	}
+
 	switch n.Op {
 	case "+":
 		if lType == builtin.IntegerType {
@@ -727,7 +884,7 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 				r := rObj.DoubleValue()
 				return builtin.NewDouble(r + l), nil
 			}
-		} else if lType == builtin.StringType {
+		} else if isStringType(lType) {
 			l := lObj.StringValue()
 			r := rObj.StringValue()
 			return builtin.NewString(l + r), nil
@@ -784,6 +941,9 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 			l := lObj.IntegerValue()
 			if rType == builtin.IntegerType {
 				r := rObj.IntegerValue()
+				if r == 0 {
+					return nil, v.raiseSystemException(builtin.MathExceptionType, n, "Divide by 0")
+				}
 				return builtin.NewInteger(l / r), nil
 			}
 			if rType == builtin.DoubleType {
@@ -895,6 +1055,9 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 		}
 		panic("type error")
 	case "==":
+		if lType == builtin.IdType || rType == builtin.IdType {
+			return builtin.NewBoolean(idEquals(lObj, rObj)), nil
+		}
 		if lType == builtin.IntegerType {
 			l := lObj.IntegerValue()
 			if rType == builtin.IntegerType {
@@ -924,6 +1087,9 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 	case "===":
 		return builtin.NewBoolean(lObj == rObj), nil
 	case "!=":
+		if lType == builtin.IdType || rType == builtin.IdType {
+			return builtin.NewBoolean(!idEquals(lObj, rObj)), nil
+		}
 		if lType == builtin.IntegerType {
 			l := lObj.IntegerValue()
 			if rType == builtin.IntegerType {
@@ -1004,35 +1170,63 @@ func (v *Interpreter) VisitThrow(n *ast.Throw) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	return builtin.CreateRaise(res.(*ast.Object)), nil
+	exception := res.(*ast.Object)
+	if exception == builtin.Null {
+		return nil, v.raiseSystemException(builtin.NullPointerExceptionType, n, "Attempt to de-reference a null object")
+	}
+	v.setStackTrace(exception, n)
+	return builtin.CreateRaise(exception), nil
 }
 
 func (v *Interpreter) VisitSoql(n *ast.Soql) (interface{}, error) {
-	executor := &SoqlExecutor{}
-	objects, err := executor.Execute(n, v)
+	objects, err := v.executeQuery(n, n, v, true)
+	if err != nil {
+		return nil, err
+	}
+	if builtin.IsCountQuery(n) {
+		return countQueryResult(objects), nil
+	}
 	if n.ExactlyOne {
 		records := objects.Extra["records"].([]*ast.Object)
 		if len(records) == 0 {
-			return nil, errors.New("List has no rows for assignment to SObject")
+			return nil, v.raiseSystemException(builtin.QueryExceptionType, n, "List has no rows for assignment to SObject")
 		}
 		if len(records) > 1 {
-			return nil, errors.New("List has more than 1 row for assignment to SObject")
+			return nil, v.raiseSystemException(builtin.QueryExceptionType, n, "List has more than 1 row for assignment to SObject")
 		}
-	}
-	if err != nil {
-		return nil, err
+		return records[0], nil
 	}
 	return objects, nil
 }
 
 func (v *Interpreter) VisitSosl(n *ast.Sosl) (interface{}, error) {
-	// TODO: impl
-	return ast.VisitSosl(v, n)
-}
+	if exception := v.Context.Limits.AddSoslQuery(); exception != nil {
+		return nil, v.raiseLimitException(exception, n)
+	}
+	term, err := n.SearchTerm.Accept(v)
+	if err != nil {
+		return nil, err
+	}
+	if term == builtin.Null {
+		return nil, v.raiseSystemException(builtin.NullPointerExceptionType, n, "Attempt to de-reference a null object")
+	}
+	// the search results fixed by Test.setFixedSearchResults override the search
+	fixedIds, _ := v.Extra["fixed_search_results"].([]string)
+	return builtin.SearchRecords(n, term.(*ast.Object).StringValue(), fixedIds, v)
+}
+
+// stringLiteralEscapes replaces the escape sequences of the string literal
+var stringLiteralEscapes = strings.NewReplacer(
+	"\\n", "\n",
+	"\\t", "\t",
+	"\\r", "\r",
+	"\\'", "'",
+	"\\\"", "\"",
+	"\\\\", "\\",
+)
 
 func (v *Interpreter) VisitStringLiteral(n *ast.StringLiteral) (interface{}, error) {
-	value := strings.Replace(n.Value, "\\n", "\n", -1)
-	return builtin.NewString(value), nil
+	return builtin.NewString(stringLiteralEscapes.Replace(n.Value)), nil
 }
 
 func (v *Interpreter) VisitSwitch(n *ast.Switch) (interface{}, error) {
@@ -1043,6 +1237,13 @@ func (v *Interpreter) VisitSwitch(n *ast.Switch) (interface{}, error) {
 	expObj := exp.(*ast.Object)
 	for _, when := range n.WhenStatements {
 		for _, cond := range when.Condition {
+			// bare names in when clause are enum constants, e.g. `when WINTER`
+			if name, ok := cond.(*ast.Name); ok && len(name.Value) == 1 {
+				if expObj.ClassType.IsEnum() && strings.EqualFold(expObj.Extra["name"].(string), name.Value[0]) {
+					return when.Statements.Accept(v)
+				}
+				continue
+			}
 			c, err := cond.Accept(v)
 			if err != nil {
 				return nil, err
@@ -1073,9 +1274,16 @@ func (v *Interpreter) VisitVariableDeclaration(n *ast.VariableDeclaration) (inte
 		if declarator.Expression != nil {
 			val, err := declarator.Expression.Accept(v)
 			if err != nil {
-				panic(err)
+				return nil, err
 			}
-			v.Context.Env.Define(declarator.Name, val.(*ast.Object))
+			value := val.(*ast.Object)
+			if n.Type == builtin.IdType {
+				value, err = v.convertToId(declarator, value)
+				if err != nil {
+					return nil, err
+				}
+			}
+			v.Context.Env.Define(declarator.Name, value)
 		} else {
 			v.Context.Env.Define(declarator.Name, builtin.Null)
 		}
@@ -1135,7 +1343,11 @@ func (v *Interpreter) VisitCastExpression(n *ast.CastExpression) (interface{}, e
 	}
 	expObj := exp.(*ast.Object)
 	if !builtin.Equals(n.CastType, expObj.ClassType) {
-		return nil, fmt.Errorf("Cast type is not match %s != %s", n.CastType.Name, expObj.ClassType.Name)
+		return nil, v.raiseSystemException(
+			builtin.TypeExceptionType,
+			n,
+			fmt.Sprintf("Invalid conversion from runtime type %s to %s", expObj.ClassType.String(), n.CastType.String()),
+		)
 	}
 	return expObj, nil
 }
@@ -1145,6 +1357,9 @@ func (v *Interpreter) VisitFieldAccess(n *ast.FieldAccess) (interface{}, error)
 	if err != nil {
 		return nil, err
 	}
+	if r.(*ast.Object) == builtin.Null {
+		return nil, v.raiseSystemException(builtin.NullPointerExceptionType, n, "Attempt to de-reference a null object")
+	}
 	f, ok := r.(*ast.Object).InstanceFields.Get(n.FieldName)
 	if !ok {
 		panic("InstanceFields#Get failed")
@@ -1152,8 +1367,14 @@ func (v *Interpreter) VisitFieldAccess(n *ast.FieldAccess) (interface{}, error)
 	return f, nil
 }
 
+// VisitType evaluates `Foo.class` to System.Type
 func (v *Interpreter) VisitType(n *ast.TypeRef) (interface{}, error) {
-	panic("not pass")
+	resolver := NewTypeResolver(v.Context)
+	classType, err := resolver.ConvertType(n)
+	if err != nil {
+		return nil, err
+	}
+	return builtin.NewTypeObject(classType), nil
 }
 
 func (v *Interpreter) VisitBlock(n *ast.Block) (interface{}, error) {
@@ -1164,6 +1385,10 @@ func (v *Interpreter) VisitBlock(n *ast.Block) (interface{}, error) {
 	}()
 	for _, stmt := range n.Statements {
 		Publish("line", v.Context, stmt)
+		v.Context.CallStack.SetLocation(stmt.GetLocation())
+		if err := v.checkStatementLimits(stmt); err != nil {
+			return nil, err
+		}
 		res, err := stmt.Accept(v)
 		if err != nil {
 			return nil, err
@@ -1196,7 +1421,7 @@ func (v *Interpreter) VisitArrayCreator(n *ast.ArrayCreator) (interface{}, error
 }
 
 func (v *Interpreter) VisitSoqlBindVariable(n *ast.SoqlBindVariable) (interface{}, error) {
-	panic("not pass")
+	return n.Expression.Accept(v)
 }
 
 func (v *Interpreter) VisitTernalyExpression(n *ast.TernalyExpression) (interface{}, error) {
@@ -1223,8 +1448,7 @@ func (v *Interpreter) VisitName(n *ast.Name) (interface{}, error) {
 	r, err := resolver.ResolveVariable(n.Value)
 	if err != nil {
 		if npe, ok := err.(*builtin.NullPointerException); ok {
-			location := n.Location
-			return nil, fmt.Errorf("null pointer exception: %s at %d:%d", npe.GetName(), location.Line, location.Column)
+			return nil, v.nullPointerException(n, npe)
 		}
 	}
 	return r, err
@@ -1242,10 +1466,47 @@ func (v *Interpreter) NewEnv(f func() (interface{}, error)) (interface{}, error)
 	return r, err
 }
 
+func isStringType(classType *ast.ClassType) bool {
+	return classType == builtin.StringType || classType == builtin.IdType
+}
+
+// idEquals compares the values as Id, the 15 characters Id equals its 18 characters Id
+func idEquals(o, other *ast.Object) bool {
+	if o == builtin.Null || other == builtin.Null {
+		return o == other
+	}
+	return builtin.IdEquals(o.StringValue(), other.StringValue())
+}
+
+// isIdField reports whether the field of the object is declared as Id, such as the Id and the reference fields of SObject
+func isIdField(obj *ast.Object, name string) bool {
+	if obj.ClassType.InstanceFields == nil {
+		return false
+	}
+	field, ok := obj.ClassType.InstanceFields.Get(name)
+	return ok && field.Type == builtin.IdType
+}
+
+// convertToId converts the String assigned to the variable or the field of Id,
+// StringException is raised if the value is not an Id
+func (v *Interpreter) convertToId(n ast.Node, value *ast.Object) (*ast.Object, error) {
+	if value.ClassType != builtin.StringType {
+		return value, nil
+	}
+	id := value.StringValue()
+	if !builtin.ValidId(id) {
+		return nil, v.raiseSystemException(builtin.StringExceptionType, n, fmt.Sprintf("Invalid id: %s", id))
+	}
+	return builtin.NewId(id), nil
+}
+
 func (v *Interpreter) Equals(o, other *ast.Object) bool {
 	if o == builtin.Null || other == builtin.Null {
 		return o == builtin.Null && other == builtin.Null
 	}
+	if o.ClassType.IsEnum() {
+		return o == other
+	}
 	m, ok := o.ClassType.InstanceMethods.Get("equals")
 	if !ok {
 		return o.Value() == other.Value()
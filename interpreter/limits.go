@@ -0,0 +1,54 @@
+package interpreter
+
+import (
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+)
+
+// the heap size is approximated every heapCheckInterval statements,
+// since it walks all reachable objects
+const heapCheckInterval = 1000
+
+func (v *Interpreter) Limits() *builtin.Limits {
+	return v.Context.Limits
+}
+
+// HeapSize approximates the heap usage by the objects reachable from
+// the local variables and the static fields
+func (v *Interpreter) HeapSize() int {
+	visited := map[*ast.Object]bool{}
+	size := 0
+	for env := v.Context.Env; env != nil; env = env.Parent {
+		for _, obj := range env.Data.Data {
+			size += builtin.HeapSizeOf(obj, visited)
+		}
+	}
+	for _, classes := range v.Context.StaticField.Data {
+		for _, fields := range classes {
+			for _, obj := range fields.Data {
+				size += builtin.HeapSizeOf(obj, visited)
+			}
+		}
+	}
+	return size
+}
+
+// checkStatementLimits raises LimitException if CPU time or heap size exceeds the limit
+func (v *Interpreter) checkStatementLimits(n ast.Node) error {
+	limits := v.Context.Limits
+	if exception := limits.CheckCpuTime(); exception != nil {
+		return v.raiseLimitException(exception, n)
+	}
+	v.Context.Statements++
+	if v.Context.Statements%heapCheckInterval == 0 {
+		if exception := limits.CheckHeapSize(v.HeapSize()); exception != nil {
+			return v.raiseLimitException(exception, n)
+		}
+	}
+	return nil
+}
+
+func (v *Interpreter) raiseLimitException(exception *ast.Object, n ast.Node) error {
+	v.setStackTrace(exception, n)
+	return builtin.NewRaiseError(exception)
+}
@@ -0,0 +1,56 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+)
+
+func TestLimitExceptionIsNotCaught(t *testing.T) {
+	thresholds := *builtin.SyncLimitThresholds
+	thresholds.Queries = 0
+	interpreter := NewInterpreter(ast.NewClassMap())
+	interpreter.Context.Limits = builtin.NewLimits(&thresholds)
+
+	query := func() *ast.Block {
+		return &ast.Block{Statements: []ast.Node{&ast.Soql{FromObject: "Account"}}}
+	}
+	try := &ast.Try{
+		Block: query(),
+		CatchClause: []*ast.Catch{
+			{Type: builtin.ExceptionType, Identifier: "e", Block: query()},
+		},
+		FinallyBlock: query(),
+	}
+	_, err := try.Accept(interpreter)
+	exception := raisedException(nil, err)
+	if exception == nil || exception.ClassType != builtin.LimitExceptionType {
+		t.Fatalf("expected LimitException, actual %v", err)
+	}
+	if message := exception.Extra["message"].(*ast.Object).StringValue(); message != "Too many SOQL queries: 1" {
+		t.Errorf("unexpected message %s", message)
+	}
+	// neither catch nor finally block is executed
+	if queries := interpreter.Limits().Queries; queries != 1 {
+		t.Errorf("expected 1 query, actual %d", queries)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	limits := builtin.NewLimits(builtin.SyncLimitThresholds)
+	for i := 0; i < 150; i++ {
+		if exception := limits.AddDml(1); exception != nil {
+			t.Fatalf("unexpected exception on dml %d", i+1)
+		}
+	}
+	if exception := limits.AddDml(1); exception == nil {
+		t.Errorf("expected LimitException on dml 151")
+	}
+	if exception := limits.AddQueryRows(50000); exception != nil {
+		t.Errorf("unexpected exception on 50000 rows")
+	}
+	if exception := limits.AddQueryRows(1); exception == nil {
+		t.Errorf("expected LimitException on 50001 rows")
+	}
+}
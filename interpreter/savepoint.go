@@ -0,0 +1,74 @@
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+)
+
+// SetSavepoint sets the savepoint of the storage, which counts as a DML statement
+func (v *Interpreter) SetSavepoint() (*ast.Object, error) {
+	if exception := v.Context.Limits.AddDml(0); exception != nil {
+		return nil, builtin.NewRaiseError(exception)
+	}
+	v.Context.SavepointCount++
+	name := fmt.Sprintf("land_savepoint_%d", v.Context.SavepointCount)
+	if err := builtin.DatabaseDriver.Savepoint(name); err != nil {
+		return nil, err
+	}
+	v.Context.Savepoints = append(v.Context.Savepoints, name)
+	return builtin.NewSavepoint(name), nil
+}
+
+// RollbackToSavepoint restores the records at the savepoint, which counts as a DML statement.
+// The savepoints set after the savepoint are no longer valid.
+// The Ids of the records inserted after the savepoint are not cleared,
+// so that they can not be inserted again.
+func (v *Interpreter) RollbackToSavepoint(savepoint *ast.Object) error {
+	if exception := v.Context.Limits.AddDml(0); exception != nil {
+		return builtin.NewRaiseError(exception)
+	}
+	name := builtin.SavepointName(savepoint)
+	for i, valid := range v.Context.Savepoints {
+		if valid != name {
+			continue
+		}
+		if err := builtin.DatabaseDriver.RollbackToSavepoint(name); err != nil {
+			return err
+		}
+		v.Context.Savepoints = v.Context.Savepoints[:i+1]
+		return nil
+	}
+	return builtin.NewRaiseError(builtin.NewException(builtin.TypeExceptionType, "Savepoint does not exist in this context."))
+}
+
+// setDmlSavepoint sets the savepoint of the storage to roll back the records saved in a DML or a batch chunk,
+// which does not count as a DML statement unlike Database.setSavepoint.
+// The returned function releases the savepoint, rolling back to it if rollback is true.
+// The savepoint is tracked with those of Database.setSavepoint, and it is not released
+// if Database.rollback in the triggers rolled back to the savepoint set before it.
+func (v *Interpreter) setDmlSavepoint() (func(rollback bool) error, error) {
+	v.Context.SavepointCount++
+	name := fmt.Sprintf("land_dml_savepoint_%d", v.Context.SavepointCount)
+	if err := builtin.DatabaseDriver.Savepoint(name); err != nil {
+		return nil, err
+	}
+	v.Context.Savepoints = append(v.Context.Savepoints, name)
+	return func(rollback bool) error {
+		for i, valid := range v.Context.Savepoints {
+			if valid != name {
+				continue
+			}
+			// the savepoints set after the savepoint are released with it
+			v.Context.Savepoints = v.Context.Savepoints[:i]
+			if rollback {
+				if err := builtin.DatabaseDriver.RollbackToSavepoint(name); err != nil {
+					return err
+				}
+			}
+			return builtin.DatabaseDriver.ReleaseSavepoint(name)
+		}
+		return nil
+	}, nil
+}
@@ -0,0 +1,143 @@
+package interpreter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+)
+
+// ScheduledJob is the Schedulable registered by System.schedule
+type ScheduledJob struct {
+	Trigger     *builtin.CronTrigger
+	Cron        *builtin.CronExpression
+	Schedulable *ast.Object // the state when the job is scheduled, which is copied for each execution
+}
+
+// Schedule registers the Schedulable for System.schedule.
+// In test, the job scheduled after Test.startTest is executed once at Test.stopTest,
+// otherwise the job is fired by the virtual clock of land schedule.
+func (v *Interpreter) Schedule(name string, cron *builtin.CronExpression, schedulable *ast.Object) (string, error) {
+	waiting := 0
+	for _, job := range v.Context.ScheduledJobs {
+		if job.Trigger.State != builtin.CronTriggerStateWaiting {
+			continue
+		}
+		if job.Trigger.Name == name {
+			message := fmt.Sprintf(`The Apex job named "%s" is already scheduled for execution.`, name)
+			return "", builtin.NewRaiseError(builtin.NewException(builtin.AsyncExceptionType, message))
+		}
+		waiting++
+	}
+	if waiting >= builtin.MaxScheduledJobs {
+		message := fmt.Sprintf("You have exceeded the maximum number (%d) of Apex scheduled jobs.", builtin.MaxScheduledJobs)
+		return "", builtin.NewRaiseError(builtin.NewException(builtin.AsyncExceptionType, message))
+	}
+	now := builtin.Now()
+	next, ok := cron.Next(now)
+	if !ok {
+		message := fmt.Sprintf("Based on configured schedule, the given trigger '%s' will never fire.", name)
+		return "", builtin.NewRaiseError(builtin.NewException(builtin.AsyncExceptionType, message))
+	}
+
+	v.Context.ScheduledJobCount++
+	trigger := &builtin.CronTrigger{
+		Id:              numberedId("CronTrigger", v.Context.ScheduledJobCount),
+		CronJobDetailId: numberedId("CronJobDetail", v.Context.ScheduledJobCount),
+		Name:            name,
+		CronExpression:  cron.Expression,
+		State:           builtin.CronTriggerStateWaiting,
+		StartTime:       now,
+		NextFireTime:    next,
+	}
+	if err := trigger.Insert(); err != nil {
+		return "", err
+	}
+	job := &ScheduledJob{
+		Trigger:     trigger,
+		Cron:        cron,
+		Schedulable: copyObject(schedulable, map[*ast.Object]*ast.Object{}),
+	}
+	v.Context.ScheduledJobs = append(v.Context.ScheduledJobs, job)
+	if v.Context.TestStarted {
+		v.EnqueueAsyncJob(func() error {
+			if trigger.State != builtin.CronTriggerStateWaiting {
+				return nil
+			}
+			return v.enqueueScheduledJob(job, builtin.Now())
+		})
+	}
+	return trigger.Id, nil
+}
+
+// NextScheduledJob returns the waiting job which fires first, or nil.
+// The job scheduled earlier fires first at the same time.
+func (v *Interpreter) NextScheduledJob() *ScheduledJob {
+	var next *ScheduledJob
+	for _, job := range v.Context.ScheduledJobs {
+		if job.Trigger.State != builtin.CronTriggerStateWaiting {
+			continue
+		}
+		if next == nil || job.Trigger.NextFireTime.Before(next.Trigger.NextFireTime) {
+			next = job
+		}
+	}
+	return next
+}
+
+// FireScheduledJob executes the job at its next fire time, with the asynchronous jobs enqueued by the job
+func (v *Interpreter) FireScheduledJob(job *ScheduledJob) error {
+	if err := v.enqueueScheduledJob(job, job.Trigger.NextFireTime); err != nil {
+		return err
+	}
+	return v.RunAsyncJobs()
+}
+
+// enqueueScheduledJob advances the trigger fired at the time, and queues the execution of the Schedulable
+func (v *Interpreter) enqueueScheduledJob(job *ScheduledJob, at time.Time) error {
+	trigger := job.Trigger
+	trigger.TimesTriggered++
+	trigger.PreviousFireTime = at
+	if next, ok := job.Cron.Next(at); ok {
+		trigger.NextFireTime = next
+	} else {
+		trigger.NextFireTime = time.Time{}
+		trigger.State = builtin.CronTriggerStateComplete
+	}
+	if err := trigger.Update(); err != nil {
+		return err
+	}
+	running := &RunningJob{
+		Record: &builtin.AsyncApexJob{
+			JobType: builtin.AsyncJobTypeScheduled,
+		},
+	}
+	_, err := v.enqueueRecordedJob(running, func() error {
+		schedulable := copyObject(job.Schedulable, map[*ast.Object]*ast.Object{})
+		context := builtin.NewSchedulableContext(trigger.Id)
+		_, err := v.InvokeMethod(schedulable, "execute", []*ast.Object{context})
+		return err
+	})
+	return err
+}
+
+// AbortJob stops the scheduled job of the CronTrigger Id, or the queued job of the AsyncApexJob Id
+func (v *Interpreter) AbortJob(jobId string) error {
+	for _, job := range v.Context.ScheduledJobs {
+		if job.Trigger.Id != jobId || job.Trigger.State == builtin.CronTriggerStateDeleted {
+			continue
+		}
+		job.Trigger.State = builtin.CronTriggerStateDeleted
+		return job.Trigger.Delete()
+	}
+	if record, ok := v.Context.AsyncApexJobs[jobId]; ok {
+		if record.Status != builtin.AsyncJobStatusQueued && record.Status != builtin.AsyncJobStatusProcessing {
+			return nil
+		}
+		record.Status = builtin.AsyncJobStatusAborted
+		return record.Update()
+	}
+	message := fmt.Sprintf("Invalid id: %s", jobId)
+	return builtin.NewRaiseError(builtin.NewException(builtin.StringExceptionType, message))
+}
@@ -1,6 +1,9 @@
 package interpreter
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/tzmfreedom/go-soapforce"
 	"github.com/tzmfreedom/land/ast"
 	"github.com/tzmfreedom/land/builtin"
@@ -9,7 +12,10 @@ import (
 type SoqlExecutor struct{}
 
 func (e *SoqlExecutor) Execute(n *ast.Soql, visitor ast.Visitor) (*ast.Object, error) {
-	records := builtin.DatabaseDriver.Query(n, visitor)
+	records, err := builtin.QueryRecords(n, visitor)
+	if err != nil {
+		return nil, err
+	}
 	return e.getListFromResponse(n, records)
 }
 
@@ -41,6 +47,9 @@ func (e *SoqlExecutor) getListFromResponse(n *ast.Soql, records []*ast.Object) (
 	if !ok {
 		panic(n.FromObject + "not found")
 	}
+	if builtin.IsAggregateQuery(n) {
+		classType = builtin.AggregateResultType
+	}
 	list := &ast.Object{
 		ClassType:      builtin.CreateListType(classType),
 		InstanceFields: ast.NewObjectMap(),
@@ -50,3 +59,187 @@ func (e *SoqlExecutor) getListFromResponse(n *ast.Soql, records []*ast.Object) (
 	}
 	return list, nil
 }
+
+// executeQuery executes the query counting the governor limits, the exceptions are raised on the node.
+// The bind variables are evaluated by the visitor.
+func (v *Interpreter) executeQuery(n ast.Node, soql *ast.Soql, visitor ast.Visitor, countRows bool) (*ast.Object, error) {
+	executor := &SoqlExecutor{}
+	if exception := v.Context.Limits.AddQuery(); exception != nil {
+		return nil, v.raiseLimitException(exception, n)
+	}
+	objects, err := executor.Execute(soql, visitor)
+	if err != nil {
+		return nil, err
+	}
+	if !countRows {
+		return objects, nil
+	}
+	rows := len(objects.Extra["records"].([]*ast.Object))
+	if builtin.IsCountQuery(soql) {
+		// the records counted by COUNT() are the rows of the query
+		rows = countQueryResult(objects).IntegerValue()
+	}
+	if exception := v.Context.Limits.AddQueryRows(rows); exception != nil {
+		return nil, v.raiseLimitException(exception, n)
+	}
+	return objects, nil
+}
+
+// countQueryResult returns the number of the records of SELECT COUNT() FROM ..., which is the only value of the result
+func countQueryResult(objects *ast.Object) *ast.Object {
+	records := objects.Extra["records"].([]*ast.Object)
+	if len(records) == 0 {
+		return builtin.NewInteger(0)
+	}
+	count, _ := records[0].InstanceFields.Get("expr0")
+	return count
+}
+
+// Query executes the SOQL query given as a string, such as Database.query('SELECT Id FROM Account').
+// The bind variables are resolved from binds of Database.queryWithBinds, or the calling scope if binds is nil.
+func (v *Interpreter) Query(query string, binds *ast.Object, countRows bool) (*ast.Object, error) {
+	soql, visitor, err := v.parseQuery(query, binds)
+	if err != nil {
+		return nil, err
+	}
+	n, _ := v.Extra["node"].(ast.Node)
+	return v.executeQuery(n, soql, visitor, countRows)
+}
+
+// CountQuery executes SELECT COUNT() FROM ... given as a string, such as Database.countQuery, and returns the number of the records
+func (v *Interpreter) CountQuery(query string, binds *ast.Object) (*ast.Object, error) {
+	soql, visitor, err := v.parseQuery(query, binds)
+	if err != nil {
+		return nil, err
+	}
+	if !builtin.IsCountQuery(soql) {
+		return nil, newQueryException("countQuery requires SELECT COUNT() FROM ...")
+	}
+	n, _ := v.Extra["node"].(ast.Node)
+	objects, err := v.executeQuery(n, soql, visitor, true)
+	if err != nil {
+		return nil, err
+	}
+	return countQueryResult(objects), nil
+}
+
+// parseQuery parses the query and checks the sObject and the fields like the static query is compiled,
+// and returns the visitor of the bind variables resolved in advance. The errors are raised as QueryException.
+func (v *Interpreter) parseQuery(query string, binds *ast.Object) (*ast.Soql, ast.Visitor, error) {
+	soql, err := ast.ParseQuery(query)
+	if err != nil {
+		return nil, nil, newQueryException(err.Error())
+	}
+	classType, ok := builtin.PrimitiveClassMap().Get(soql.FromObject)
+	if !ok || !builtin.Equals(builtin.SObjectType, classType) {
+		return nil, nil, newQueryException(fmt.Sprintf("sObject type '%s' is not supported.", soql.FromObject))
+	}
+	if err := builtin.ValidateQuery(soql); err != nil {
+		return nil, nil, newQueryException(err.Error())
+	}
+	if err := builtin.GroupingSetsOrderError(soql); err != nil {
+		return nil, nil, newQueryException(err.Error())
+	}
+	values := map[*ast.SoqlBindVariable]*ast.Object{}
+	for _, variable := range soqlBindVariables(soql) {
+		value, err := v.evaluateBindVariable(variable, binds)
+		if err != nil {
+			return nil, nil, err
+		}
+		values[variable] = value
+	}
+	return soql, &boundVisitor{Visitor: v, values: values}, nil
+}
+
+// evaluateBindVariable evaluates the bind variable in the calling scope, or by the key of binds which is case insensitive
+func (v *Interpreter) evaluateBindVariable(n *ast.SoqlBindVariable, binds *ast.Object) (*ast.Object, error) {
+	if binds == nil {
+		value, err := n.Expression.Accept(v)
+		if err != nil {
+			if _, ok := err.(*builtin.RaiseError); ok {
+				return nil, err
+			}
+			return nil, newQueryException(fmt.Sprintf("Variable does not exist: %s", bindVariableName(n)))
+		}
+		return value.(*ast.Object), nil
+	}
+	name, ok := n.Expression.(*ast.Name)
+	if !ok {
+		return nil, newQueryException(fmt.Sprintf("Bind expression is not supported in queryWithBinds: %s", bindVariableName(n)))
+	}
+	var value *ast.Object
+	for key, bind := range binds.Extra["values"].(map[string]*ast.Object) {
+		if strings.EqualFold(key, name.Value[0]) {
+			value = bind
+			break
+		}
+	}
+	if value == nil {
+		return nil, newQueryException(fmt.Sprintf("Key '%s' does not exist in the bindMap", name.Value[0]))
+	}
+	// the rest of the path such as :account.Name is the fields of the value
+	for _, field := range name.Value[1:] {
+		if value == builtin.Null {
+			return nil, builtin.NewRaiseError(builtin.NewException(builtin.NullPointerExceptionType, "Attempt to de-reference a null object"))
+		}
+		fieldValue, ok := value.InstanceFields.Get(field)
+		if !ok {
+			fieldValue = builtin.Null
+		}
+		value = fieldValue
+	}
+	return value, nil
+}
+
+func bindVariableName(n *ast.SoqlBindVariable) string {
+	if name, ok := n.Expression.(*ast.Name); ok {
+		return strings.Join(name.Value, ".")
+	}
+	return n.Expression.GetType()
+}
+
+func newQueryException(message string) error {
+	return builtin.NewRaiseError(builtin.NewException(builtin.QueryExceptionType, message))
+}
+
+// soqlBindVariables returns the bind variables of the conditions, LIMIT and OFFSET of the query and its subqueries
+func soqlBindVariables(n *ast.Soql) []*ast.SoqlBindVariable {
+	variables := []*ast.SoqlBindVariable{}
+	var collect func(ast.Node)
+	collect = func(node ast.Node) {
+		switch val := node.(type) {
+		case *ast.SoqlBindVariable:
+			variables = append(variables, val)
+		case *ast.WhereBinaryOperator:
+			collect(val.Left)
+			collect(val.Right)
+		case *ast.WhereCondition:
+			collect(val.Expression)
+		}
+	}
+	for _, field := range n.SelectFields {
+		if subquery, ok := field.(*ast.Soql); ok {
+			variables = append(variables, soqlBindVariables(subquery)...)
+		}
+	}
+	collect(n.Where)
+	if n.Group != nil {
+		collect(n.Group.Having)
+	}
+	collect(n.Limit)
+	collect(n.Offset)
+	return variables
+}
+
+// boundVisitor returns the values of the bind variables resolved in advance, and evaluates the literals by the interpreter
+type boundVisitor struct {
+	ast.Visitor
+	values map[*ast.SoqlBindVariable]*ast.Object
+}
+
+func (v *boundVisitor) VisitSoqlBindVariable(n *ast.SoqlBindVariable) (interface{}, error) {
+	if value, ok := v.values[n]; ok {
+		return value, nil
+	}
+	return v.Visitor.VisitSoqlBindVariable(n)
+}
@@ -0,0 +1,29 @@
+package interpreter
+
+import "github.com/tzmfreedom/land/builtin"
+
+// StartTest gives the code between Test.startTest and Test.stopTest a fresh set of governor limits
+func (v *Interpreter) StartTest() error {
+	if v.Context.TestStarted {
+		return builtin.NewRaiseError(builtin.NewException(builtin.FinalExceptionType, "Testing already started"))
+	}
+	v.Context.TestStarted = true
+	v.Context.TestLimits = v.Context.Limits
+	v.Context.Limits = builtin.NewLimits(v.Context.Limits.Thresholds)
+	return nil
+}
+
+// StopTest executes the queued asynchronous jobs synchronously,
+// and restores the governor limits before Test.startTest
+func (v *Interpreter) StopTest() error {
+	err := v.RunAsyncJobs()
+	if v.Context.TestLimits != nil {
+		v.Context.Limits = v.Context.TestLimits
+		v.Context.TestLimits = nil
+	}
+	return err
+}
+
+func (v *Interpreter) IsRunningTest() bool {
+	return v.Context.IsRunningTest
+}
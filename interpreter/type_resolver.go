@@ -111,7 +111,7 @@ func setVariable(receiver *ast.Object, name string, value *ast.Object) error {
 	if v.Final {
 		return errors.New("Final variable has already been initialized")
 	}
-	receiver.InstanceFields.Set(name, value)
+	builtin.SetField(receiver, name, value)
 	return nil
 }
 
@@ -145,7 +145,7 @@ func (r *TypeResolver) ResolveVariable(names []string) (*ast.Object, error) {
 			return val, nil
 		}
 		// this
-		if val, ok := r.Context.Env.Get("this"); ok {
+		if val, ok := r.Context.Env.Get("this"); ok && hasInstanceField(val, name) {
 			for _, f := range names[0:] {
 				val, ok = val.InstanceFields.Get(f)
 				if !ok {
@@ -185,10 +185,21 @@ func (r *TypeResolver) ResolveVariable(names []string) (*ast.Object, error) {
 				return val, nil
 			}
 		}
+		// search for enum constant of inner class or namespace
+		if classType, err := r.ResolveType(names[:len(names)-1]); err == nil && classType.IsEnum() {
+			if val := builtin.EnumValueOf(classType, names[len(names)-1]); val != nil {
+				return val, nil
+			}
+		}
 	}
 	return nil, nil
 }
 
+func hasInstanceField(obj *ast.Object, name string) bool {
+	_, ok := obj.InstanceFields.Get(name)
+	return ok
+}
+
 func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.Object) (interface{}, *ast.Method, error) {
 	if len(names) == 1 {
 		methodName := names[0]
@@ -257,6 +268,15 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.Object) (
 				}
 			}
 		}
+
+		// search for static method of inner class, or instance method of enum constant
+		receiverNames := names[:len(names)-1]
+		if classType, err := r.ResolveType(receiverNames); err == nil {
+			return FindStaticMethod(classType, methodName, parameters, compiler.MODIFIER_ALL_OK)
+		}
+		if val, err := r.ResolveVariable(receiverNames); err == nil && val != nil {
+			return FindInstanceMethod(val, methodName, parameters, compiler.MODIFIER_ALL_OK)
+		}
 	}
 	return nil, nil, errors.Errorf("%s is not found in this scope", strings.Join(names, "."))
 }
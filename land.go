@@ -31,6 +31,7 @@ func main() {
 		evalServerCommand,
 		formatCommand,
 		runCommand,
+		scheduleCommand,
 		checkCommand,
 		visualforceCommand,
 	}
@@ -1,7 +1,13 @@
 package main
 
 import (
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"time"
 
 	"github.com/tzmfreedom/land/ast"
 )
@@ -79,3 +85,586 @@ func ExampleAbstract() {
 	// hello
 	// world
 }
+
+// Try, Catch, Finally, Throw
+func ExampleTryCatch() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "TryCatch#main", "-d", "fixtures/exception"}
+	main()
+	// Output:
+	// subclass
+	// MyException: first match
+	// inner
+	// inner finally
+	// outer: rethrow
+	// finally on return
+	// returned
+	// 0
+	// loop finally
+	// loop finally
+	// loop finally
+}
+
+// Built-in exceptions and Exception methods
+func ExampleExceptionTypes() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "ExceptionTypes#main", "-d", "fixtures/exception"}
+	main()
+	// Output:
+	// System.NullPointerException
+	// System.ListException: List index out of bounds: 1
+	// Divide by 0
+	// List has no rows for assignment to SObject
+	// Starting position out of bounds: 5
+	// System.DmlException: dml
+	// MyException
+	// cause
+	// changed
+	// cause
+	// MyException: cause
+	// 54
+}
+
+// Stack trace of thrown exceptions
+func ExampleStackTrace() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "StackTrace#main", "-d", "fixtures/exception"}
+	main()
+	// Output:
+	// 26
+	// Class.StackTrace.inner: line 26, column 8
+	// Class.StackTrace.outer: line 22, column 8
+	// Class.StackTrace.main: line 4, column 12
+	// Class.StackTrace.<init>: line 18, column 21
+	// Class.StackTrace.main: line 11, column 16
+}
+
+// Enum
+func ExampleEnum() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "EnumSample#main", "-d", "fixtures/enum"}
+	main()
+	// Output:
+	// SUMMER
+	// SUMMER
+	// 2
+	// 4
+	// true
+	// false
+	// cold
+	// mild
+	// hot
+	// cold
+	// 3
+	// WINTER
+	// RED
+	// 1
+	// true
+}
+
+// In-memory storage without SQL
+func ExampleMemoryQuery() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "MemoryQuery#main", "-d", "fixtures/memory", "--database", "memory://"}
+	main()
+	// Output:
+	// Acme
+	// Grand Hotels
+	// Grand Hotels
+	// Green Power
+	// Grand Hotels
+	// Global Media
+	// Acme
+	// Acme
+	// Energy
+	// 2
+	// 800.000000
+}
+
+// Database.setSavepoint and Database.rollback
+func ExampleSavepoint() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "SavepointSample#main", "-d", "fixtures/savepoint", "--database", "sqlite3://:memory:"}
+	main()
+	// Output:
+	// 1
+	// Savepoint does not exist in this context.
+	// Insert failed
+	// 8
+	// 0
+	// 1
+}
+
+// Id
+func ExampleId() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "IdSample#main", "-d", "fixtures/id", "--database", "memory://"}
+	main()
+	// Output:
+	// 18
+	// 15
+	// 001
+	// Account
+	// true
+	// true
+	// Acme
+	// 18
+	// Acme
+	// Acme Corp
+	// 001A0000006Vm9rIAC
+	// true
+	// Invalid id: Acme
+	// Invalid id: 001A0000006Vm9rXXX
+}
+
+// Typed fields
+func ExampleTypedFields() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "TypedFields#main", "-d", "fixtures/typed", "--database", "sqlite3://:memory:"}
+	main()
+	// Output:
+	// 121
+	// 1500.500000
+	// false
+	// 2024-03-16
+	// true
+	// null
+	// 1
+	// null
+	// 120
+}
+
+// Bind variables
+func ExampleBindVariables() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "BindVariables#main", "-d", "fixtures/bind", "--database", "sqlite3://:memory:"}
+	main()
+	// Output:
+	// O'Brien
+	// {"name": "O'Brien", "tags": ["a", "b"]}
+	// It's updated
+	// 2
+	// 1
+}
+
+// ORDER BY, LIMIT and OFFSET
+func ExampleOrder() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "OrderSample#main", "-d", "fixtures/order", "--database", "sqlite3://:memory:"}
+	main()
+	// Output:
+	// Global Media
+	// Green Power
+	// Acme
+	// Grand Hotels
+	// Global Media
+	// Grand Hotels
+	// Grand Hotels
+	// Acme
+	// Acme
+}
+
+func ExampleAggregate() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "AggregateSample#main", "-d", "fixtures/aggregate", "--database", "sqlite3://:memory:"}
+	main()
+	// Output:
+	// 2
+	// Prospecting, 2
+	// Closed Won, 2
+	// 900.000000
+	// 300.000000
+	// 100.000000
+	// Upsell
+	// 2
+	// null, null, 900.000000, 1, 1
+	// Closed Won, null, 800.000000, 0, 1
+	// Closed Won, Phone, 300.000000, 0, 0
+	// Closed Won, Web, 500.000000, 0, 0
+	// Prospecting, null, null, 0, 0
+	// Prospecting, null, 100.000000, 0, 1
+	// Prospecting, Web, 100.000000, 0, 0
+	// Web, 2
+	// null, 4
+	// null, 4
+	// Closed Won, 2
+	// Prospecting, 2
+	// ORDER BY field must be selected with ROLLUP or CUBE: LeadSource
+}
+
+func ExampleRelationship() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "RelationshipSample#main", "-d", "fixtures/relationship", "-m", "fixtures/relationship/metafile.yml", "--database", "sqlite3://:memory:"}
+	main()
+	// Output:
+	// Brown, Global Holdings, Margaret, Acme
+	// Jones, Global Holdings, Margaret, Acme
+	// 3
+	// Brown
+	// Acme: 2 contacts, 0 child accounts
+	//   Jones
+	//   Smith
+	// Global Holdings: 0 contacts, 2 child accounts
+	//   Acme
+	//   Initech
+	// Initech: 1 contacts, 0 child accounts
+	//   Brown
+	// 1
+	// Jones
+}
+
+func ExampleDynamicQuery() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "DynamicQuery#main", "-d", "fixtures/dynamic", "--database", "sqlite3://:memory:"}
+	main()
+	// Output:
+	// 1
+	// Acme
+	// Acme
+	// Globex
+	// 2
+	// Globex
+	// Acme
+	// 2
+	// SELECT Id FROM Account WHERE Name = :name
+	// QueryException
+	// Variable does not exist: unknown
+	// Key 'name' does not exist in the bindMap
+	// countQuery requires SELECT COUNT() FROM ...
+	// No such column 'Bogus__c' on entity 'Account'.
+	// Didn't understand relationship 'Foo' in field path.
+	// No such column 'Bogus__c' on entity 'Account'.
+	// No such column 'Bogus__c' on entity 'Account'.
+}
+
+// SOSL
+func ExampleSosl() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "SoslSample#main", "-d", "fixtures/sosl", "--database", "memory://"}
+	main()
+	// Output:
+	// 2
+	// Acme Corporation
+	// Globex
+	// Coyote
+	// 1
+	// 0
+	// Globex
+	// Coyote
+	// 1
+	// Acme Corporation
+	// Globex
+	// 0
+	// 6
+}
+
+// DML
+func ExampleDml() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "DmlSample#main", "-d", "fixtures/dml", "-m", "fixtures/dml/metafile.yml", "--database", "memory://"}
+	main()
+	// Output:
+	// true
+	// false
+	// REQUIRED_FIELD_MISSING
+	// Required fields are missing: [LastName]
+	// <List> {
+	//   LastName
+	// }
+	// false
+	// INVALID_CROSS_REFERENCE_KEY
+	// invalid cross reference id
+	// <List> {
+	//   AccountId
+	// }
+	// false
+	// FIELD_CUSTOM_VALIDATION_EXCEPTION
+	// LastName is invalid
+	// <List> {}
+	// true
+	// null
+	// 1
+	// Insert failed. First exception on row 1; first error: REQUIRED_FIELD_MISSING, Required fields are missing: [LastName]: [LastName]
+	// 1
+	// 1
+	// null
+	// REQUIRED_FIELD_MISSING
+	// Required fields are missing: [LastName]
+	// <List> {
+	//   LastName
+	// }
+	// null
+	// 1
+	// Update failed. First exception on row 1; first error: MISSING_ARGUMENT, Id not specified in an update call: []
+	// LastName is invalid
+	// REQUIRED_FIELD_MISSING
+	// true
+	// DUPLICATE_EXTERNAL_ID
+	// false
+	// false
+	// ENTITY_IS_DELETED
+	// Id not specified in a delete call
+}
+
+// Trigger
+func ExampleTrigger() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "TriggerSample#main", "-d", "fixtures/trigger"}
+	main()
+	// Output:
+	// false
+	// BEFORE_INSERT
+	// 1
+	// AFTER_INSERT
+	// 1
+	// created by trigger
+	// BEFORE_UPDATE
+	// 1
+	// AFTER_UPDATE
+	// 1
+	// foo => bar
+	// BEFORE_INSERT
+	// 1
+	// Insert failed. First exception on row 0; first error: FIELD_CUSTOM_VALIDATION_EXCEPTION, Name is invalid: []
+	// BEFORE_DELETE
+	// 1
+	// true
+	// AFTER_DELETE
+	// 1
+	// true
+	// AFTER_UNDELETE
+	// 1
+}
+
+// Limits
+func ExampleLimits() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "LimitsSample#action", "-d", "fixtures/limits"}
+	main()
+	// Output:
+	// 0
+	// 1
+	// 100
+	// 2
+	// 3
+	// 10000
+	// 10000
+	// 6291456
+}
+
+func ExampleLimitsConfig() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "LimitsSample#action", "-d", "fixtures/limits", "--limits", "fixtures/limits/limits.yml"}
+	main()
+	// Output:
+	// 0
+	// 1
+	// 50
+	// 2
+	// 3
+	// 10000
+	// 20000
+	// 6291456
+}
+
+// Test
+func ExampleTestContext() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "TestContextSample#main", "-d", "fixtures/test_context"}
+	main()
+	// Output:
+	// false
+	// 2
+	// 2
+	// 1
+	// 1
+	// 0
+	// 0
+	// 1
+	// 1
+	// 01s000000000000AAA
+	// Testing already started
+	// 3001.000000
+	// 5.000000
+}
+
+// Callout
+func ExampleCallout() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "CalloutSample#main", "-d", "fixtures/callout"}
+	main()
+	// Output:
+	// 201
+	// text/plain
+	// echo
+	// 200
+	// {"name": "static"}
+	// second
+	// first
+	// No static resource for the endpoint: https://example.com/third
+	// hello greet
+	// 6
+}
+
+// Http
+func ExampleHttpCallout() {
+	setup()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/echo":
+			body, _ := ioutil.ReadAll(r.Body)
+			w.Header().Set("X-Echo-Path", r.URL.RequestURI())
+			w.Header().Set("X-Echo-Authorization", r.Header.Get("Authorization"))
+			w.Header().Set("X-Echo-Request", r.Header.Get("X-Request"))
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, "%s %s", r.Method, body)
+		case "/gzip":
+			reader, _ := gzip.NewReader(r.Body)
+			body, _ := ioutil.ReadAll(reader)
+			fmt.Fprintf(w, "%s %s", r.Header.Get("Content-Encoding"), body)
+		case "/slow":
+			time.Sleep(500 * time.Millisecond)
+		}
+	}))
+	defer server.Close()
+	config, _ := ioutil.TempFile("", "callouts")
+	defer os.Remove(config.Name())
+	fmt.Fprintf(config, `named_credentials:
+  Echo:
+    endpoint: %s/
+    headers:
+      Authorization: Bearer token
+`, server.URL)
+	config.Close()
+
+	os.Args = []string{"land", "run", "-a", "HttpSample#main", "-d", "fixtures/http", "--callouts", config.Name()}
+	main()
+	// Output:
+	// 201
+	// Created
+	// /echo?q=1
+	// Bearer token
+	// foo
+	// <List> {
+	//   Content-Length,
+	//   Content-Type,
+	//   Date,
+	//   X-Echo-Authorization,
+	//   X-Echo-Path,
+	//   X-Echo-Request
+	// }
+	// POST hello
+	// 10
+	// gzip compressed
+	// Read timed out
+	// Named credential not found: Unknown
+	// Timeout must be between 1 and 120000
+}
+
+// Async
+func ExampleAsync() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "AsyncSample#main", "-d", "fixtures/async"}
+	main()
+	// Output:
+	// 707000000000002AAA
+	// 2
+	// 1
+	// before stopTest
+	// sync true
+	// Future method cannot be called from a future or batch method: AsyncSample.log
+	// chained 2 707000000000002AAA
+	// Callout not allowed from this future method. Please enable callout=true for the future method.
+	// chained 6 707000000000004AAA
+	// chained 1 707000000000006AAA
+	// chained 5 707000000000007AAA
+	// chained 4 707000000000008AAA
+	// chained 3 707000000000009AAA
+	// chained 2 707000000000010AAA
+	// Maximum stack depth has been reached.
+	// Attempt to de-reference a null object: length
+	// 707000000000001AAA,Future,Completed,log,
+	// 707000000000002AAA,Queueable,Completed,,
+	// 707000000000003AAA,Future,Completed,futureCallout,
+	// 707000000000004AAA,Queueable,Completed,,
+	// 707000000000005AAA,Queueable,Failed,,System.NullPointerException: Attempt to de-reference a null object: length
+	// 707000000000006AAA,Queueable,Completed,,
+	// 707000000000007AAA,Queueable,Completed,,
+	// 707000000000008AAA,Queueable,Completed,,
+	// 707000000000009AAA,Queueable,Completed,,
+	// 707000000000010AAA,Queueable,Completed,,
+	// Completed
+}
+
+func ExampleBatch() {
+	setup()
+	os.Args = []string{"land", "run", "-a", "BatchSample#main", "-d", "fixtures/batch"}
+	main()
+	// Output:
+	// 707000000000001AAA
+	// Batch size must be greater than 0
+	// sObject type 'Unknown' is not supported.
+	// stateful chunk 2 2 0 true
+	// stateful chunk 2 4 0 true
+	// stateful chunk 1 5 0 true
+	// stateful finish 5 707000000000001AAA
+	// chunk 3 3 0 true
+	// chunk 2 2 0 true
+	// finish 0 707000000000002AAA
+	// letters abc
+	// failing first
+	// failing third
+	// failing finish
+	// letters abc
+	// Attempt to de-reference a null object: length
+	// 707000000000001AAA,BatchApex,Completed,3,3,0,
+	// 707000000000002AAA,BatchApex,Completed,2,2,0,
+	// 707000000000003AAA,BatchApex,Completed,1,1,0,
+	// 707000000000004AAA,BatchApex,Completed,3,3,1,First error: System.NullPointerException: Attempt to de-reference a null object: length
+	// 707000000000005AAA,BatchApex,Completed,1,1,0,
+	// failing first
+	// failing third
+}
+
+func ExampleSchedule() {
+	setup()
+	os.Args = []string{"land", "schedule", "-a", "ScheduleSample#main", "-d", "fixtures/schedule", "--start", "2026-01-29 12:00:00", "--duration", "96h"}
+	main()
+	// Output:
+	// 08e000000000001AAA
+	// Seconds and minutes must be specified as integers: 0 0/30 * * * ?
+	// The Apex job named "Nightly cleanup" is already scheduled for execution.
+	// Based on configured schedule, the given trigger 'Past' will never fire.
+	// [2026-01-30 02:00:00] Nightly cleanup (08e000000000001AAA)
+	// nightly 30 2 1 true
+	// purge nightly
+	// [2026-01-30 09:30:00] Weekday report (08e000000000002AAA)
+	// report 08e000000000002AAA
+	// Callout from scheduled Apex not supported.
+	// [2026-01-31 00:00:00] Month end (08e000000000003AAA)
+	// month end 31 0 1 true
+	// purge month end
+	// [2026-01-31 02:00:00] Nightly cleanup (08e000000000001AAA)
+	// nightly 31 2 1 true
+	// purge nightly
+	// [2026-02-01 02:00:00] Nightly cleanup (08e000000000001AAA)
+	// nightly 1 2 1 true
+	// purge nightly
+	// [2026-02-02 09:30:00] Weekday report (08e000000000002AAA)
+	// report 08e000000000002AAA
+	// Callout from scheduled Apex not supported.
+}
+
+func ExampleScheduleTest() {
+	setup()
+	os.Args = []string{"land", "schedule", "-a", "ScheduleSample#test", "-d", "fixtures/schedule", "--start", "2026-01-29 12:00:00", "--duration", "1h"}
+	main()
+	// Output:
+	// 0 0 2 * * ?,WAITING,0
+	// test 29 12 1 true
+	// purge test
+	// WAITING,1
+	// 1
+	// 707000000000001AAA,ScheduledApex,Completed
+	// 707000000000002AAA,Future,Completed
+}
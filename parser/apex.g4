@@ -407,6 +407,7 @@ whenStatement
 whenExpression
     :   literal (',' literal)*
     |   apexType apexIdentifier
+    |   apexIdentifier (',' apexIdentifier)*
     ;
 
 forControl
@@ -616,7 +617,7 @@ fieldList
     ;
 
 selectField
-    : soqlField
+    : soqlField apexIdentifier?
     | subquery
     | TYPEOF soqlField
       (WHEN apexIdentifier THEN fieldList)+
@@ -638,7 +639,7 @@ soqlField
     ;
 
 subquery
-    : query
+    : LPAREN query RPAREN
     ;
 
 whereClause
@@ -674,7 +675,8 @@ limitClause
     ;
 
 orderClause
-    :  ORDER BY soqlField (',' soqlField)* asc_desc=(ASC | DESC)? (NULLS nulls=(LAST | FIRST))?
+    :  ORDER BY soqlField (ASC | DESC)? (NULLS (LAST | FIRST))?
+       (',' soqlField (ASC | DESC)? (NULLS (LAST | FIRST))?)*
     ;
 
 bindVariable
@@ -696,7 +698,8 @@ soqlFilteringExpression
     ;
 
 groupClause
-    :  GROUP BY soqlField (',' soqlField)* (HAVING havingConditionExpression)?
+    :  GROUP BY ((ROLLUP | CUBE) LPAREN soqlField (',' soqlField)* RPAREN | soqlField (',' soqlField)*)
+       (HAVING havingConditionExpression)?
     ;
 
 havingConditionExpression
@@ -718,11 +721,12 @@ soslLiteral
 	;
 
 soslQuery
-    : FIND literal IN ALL FIELDS RETURNING soslReturningObject (',' soslReturningObject)*
+    : FIND (StringLiteral | bindVariable) (IN (ALL | Identifier) FIELDS)?
+      (RETURNING soslReturningObject (',' soslReturningObject)*)?
     ;
 
 soslReturningObject
-    : Identifier ('(' Identifier (',' Identifier)* ')')?
+    : Identifier (LPAREN soqlField (',' soqlField)* whereClause? limitClause? RPAREN)?
     ;
 apexIdentifier
     :  Identifier
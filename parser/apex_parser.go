@@ -16,7 +16,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 166, 1484,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 166, 1532,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -116,90 +116,97 @@ var parserATN = []uint16{
 	67, 14, 67, 887, 11, 67, 3, 68, 3, 68, 3, 68, 3, 69, 3, 69, 7, 69, 894,
 	10, 69, 12, 69, 14, 69, 897, 11, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 71,
 	3, 71, 3, 71, 7, 71, 906, 10, 71, 12, 71, 14, 71, 909, 11, 71, 3, 71, 3,
-	71, 3, 71, 5, 71, 914, 10, 71, 3, 72, 3, 72, 5, 72, 918, 10, 72, 3, 72,
-	3, 72, 5, 72, 922, 10, 72, 3, 72, 3, 72, 5, 72, 926, 10, 72, 5, 72, 928,
-	10, 72, 3, 73, 3, 73, 5, 73, 932, 10, 73, 3, 74, 7, 74, 935, 10, 74, 12,
-	74, 14, 74, 938, 11, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 75, 3, 75,
-	3, 76, 3, 76, 3, 76, 3, 76, 3, 77, 3, 77, 3, 77, 7, 77, 954, 10, 77, 12,
-	77, 14, 77, 957, 11, 77, 3, 78, 3, 78, 3, 79, 3, 79, 3, 80, 3, 80, 3, 80,
-	3, 80, 3, 80, 3, 80, 5, 80, 969, 10, 80, 3, 81, 3, 81, 3, 82, 3, 82, 3,
+	71, 3, 71, 3, 71, 3, 71, 3, 71, 7, 71, 917, 10, 71, 12, 71, 14, 71, 920,
+	11, 71, 5, 71, 922, 10, 71, 3, 72, 3, 72, 5, 72, 926, 10, 72, 3, 72, 3,
+	72, 5, 72, 930, 10, 72, 3, 72, 3, 72, 5, 72, 934, 10, 72, 5, 72, 936, 10,
+	72, 3, 73, 3, 73, 5, 73, 940, 10, 73, 3, 74, 7, 74, 943, 10, 74, 12, 74,
+	14, 74, 946, 11, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 75, 3, 75, 3,
+	76, 3, 76, 3, 76, 3, 76, 3, 77, 3, 77, 3, 77, 7, 77, 962, 10, 77, 12, 77,
+	14, 77, 965, 11, 77, 3, 78, 3, 78, 3, 79, 3, 79, 3, 80, 3, 80, 3, 80, 3,
+	80, 3, 80, 3, 80, 5, 80, 977, 10, 80, 3, 81, 3, 81, 3, 82, 3, 82, 3, 82,
+	3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 5,
+	82, 994, 10, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82,
+	3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 5, 82, 1010, 10, 82, 3, 82, 3,
 	82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82,
-	5, 82, 986, 10, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3,
-	82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 5, 82, 1002, 10, 82, 3, 82,
 	3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3,
 	82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82,
 	3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3,
-	82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82,
-	3, 82, 3, 82, 5, 82, 1049, 10, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3,
-	82, 7, 82, 1057, 10, 82, 12, 82, 14, 82, 1060, 11, 82, 3, 83, 3, 83, 3,
-	83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83,
-	3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 5, 83, 1081, 10, 83, 3, 83, 3,
-	83, 3, 83, 5, 83, 1086, 10, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84,
-	3, 84, 3, 84, 3, 84, 5, 84, 1097, 10, 84, 5, 84, 1099, 10, 84, 3, 85, 3,
-	85, 5, 85, 1103, 10, 85, 3, 85, 3, 85, 3, 85, 5, 85, 1108, 10, 85, 7, 85,
-	1110, 10, 85, 12, 85, 14, 85, 1113, 11, 85, 3, 85, 3, 85, 3, 85, 5, 85,
-	1118, 10, 85, 3, 86, 3, 86, 5, 86, 1122, 10, 86, 3, 86, 3, 86, 3, 87, 3,
-	87, 7, 87, 1128, 10, 87, 12, 87, 14, 87, 1131, 11, 87, 3, 87, 3, 87, 3,
-	87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 7, 87, 1142, 10, 87, 12,
-	87, 14, 87, 1145, 11, 87, 3, 87, 7, 87, 1148, 10, 87, 12, 87, 14, 87, 1151,
-	11, 87, 5, 87, 1153, 10, 87, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88,
-	3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 7, 88, 1166, 10, 88, 12, 88, 14, 88,
-	1169, 11, 88, 3, 88, 3, 88, 5, 88, 1173, 10, 88, 3, 89, 3, 89, 5, 89, 1177,
-	10, 89, 3, 90, 3, 90, 5, 90, 1181, 10, 90, 3, 91, 3, 91, 3, 91, 3, 91,
-	7, 91, 1187, 10, 91, 12, 91, 14, 91, 1190, 11, 91, 3, 91, 3, 91, 3, 92,
-	3, 92, 5, 92, 1196, 10, 92, 3, 93, 3, 93, 5, 93, 1200, 10, 93, 3, 94, 3,
-	94, 3, 94, 3, 95, 3, 95, 3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 5, 96, 1212,
-	10, 96, 3, 97, 3, 97, 3, 97, 5, 97, 1217, 10, 97, 3, 98, 3, 98, 3, 98,
-	3, 98, 5, 98, 1223, 10, 98, 5, 98, 1225, 10, 98, 3, 99, 3, 99, 3, 99, 3,
-	99, 3, 99, 5, 99, 1232, 10, 99, 3, 100, 3, 100, 5, 100, 1236, 10, 100,
+	82, 3, 82, 5, 82, 1057, 10, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82,
+	7, 82, 1065, 10, 82, 12, 82, 14, 82, 1068, 11, 82, 3, 83, 3, 83, 3, 83,
+	3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3,
+	83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 5, 83, 1089, 10, 83, 3, 83, 3, 83,
+	3, 83, 5, 83, 1094, 10, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3,
+	84, 3, 84, 3, 84, 5, 84, 1105, 10, 84, 5, 84, 1107, 10, 84, 3, 85, 3, 85,
+	5, 85, 1111, 10, 85, 3, 85, 3, 85, 3, 85, 5, 85, 1116, 10, 85, 7, 85, 1118,
+	10, 85, 12, 85, 14, 85, 1121, 11, 85, 3, 85, 3, 85, 3, 85, 5, 85, 1126,
+	10, 85, 3, 86, 3, 86, 5, 86, 1130, 10, 86, 3, 86, 3, 86, 3, 87, 3, 87,
+	7, 87, 1136, 10, 87, 12, 87, 14, 87, 1139, 11, 87, 3, 87, 3, 87, 3, 87,
+	3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 7, 87, 1150, 10, 87, 12, 87,
+	14, 87, 1153, 11, 87, 3, 87, 7, 87, 1156, 10, 87, 12, 87, 14, 87, 1159,
+	11, 87, 5, 87, 1161, 10, 87, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88,
+	3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 7, 88, 1174, 10, 88, 12, 88, 14, 88,
+	1177, 11, 88, 3, 88, 3, 88, 5, 88, 1181, 10, 88, 3, 89, 3, 89, 5, 89, 1185,
+	10, 89, 3, 90, 3, 90, 5, 90, 1189, 10, 90, 3, 91, 3, 91, 3, 91, 3, 91,
+	7, 91, 1195, 10, 91, 12, 91, 14, 91, 1198, 11, 91, 3, 91, 3, 91, 3, 92,
+	3, 92, 5, 92, 1204, 10, 92, 3, 93, 3, 93, 5, 93, 1208, 10, 93, 3, 94, 3,
+	94, 3, 94, 3, 95, 3, 95, 3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 5, 96, 1220,
+	10, 96, 3, 97, 3, 97, 3, 97, 5, 97, 1225, 10, 97, 3, 98, 3, 98, 3, 98,
+	3, 98, 5, 98, 1231, 10, 98, 5, 98, 1233, 10, 98, 3, 99, 3, 99, 3, 99, 3,
+	99, 3, 99, 5, 99, 1240, 10, 99, 3, 100, 3, 100, 5, 100, 1244, 10, 100,
 	3, 100, 3, 100, 3, 101, 3, 101, 3, 101, 3, 101, 3, 102, 3, 102, 3, 102,
-	5, 102, 1247, 10, 102, 3, 102, 5, 102, 1250, 10, 102, 3, 102, 5, 102, 1253,
-	10, 102, 3, 102, 5, 102, 1256, 10, 102, 3, 102, 5, 102, 1259, 10, 102,
-	3, 102, 5, 102, 1262, 10, 102, 3, 102, 5, 102, 1265, 10, 102, 3, 103, 3,
-	103, 3, 103, 3, 104, 3, 104, 3, 104, 7, 104, 1273, 10, 104, 12, 104, 14,
-	104, 1276, 11, 104, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3,
-	105, 3, 105, 3, 105, 6, 105, 1287, 10, 105, 13, 105, 14, 105, 1288, 3,
-	105, 3, 105, 3, 105, 3, 105, 5, 105, 1295, 10, 105, 3, 106, 3, 106, 3,
-	106, 3, 106, 3, 106, 5, 106, 1302, 10, 106, 3, 107, 3, 107, 3, 108, 3,
-	108, 3, 108, 7, 108, 1309, 10, 108, 12, 108, 14, 108, 1312, 11, 108, 3,
-	108, 3, 108, 3, 108, 3, 108, 3, 108, 3, 108, 7, 108, 1320, 10, 108, 12,
-	108, 14, 108, 1323, 11, 108, 5, 108, 1325, 10, 108, 3, 108, 3, 108, 5,
-	108, 1329, 10, 108, 3, 109, 3, 109, 3, 110, 3, 110, 3, 110, 3, 111, 3,
-	111, 3, 111, 3, 111, 3, 111, 3, 111, 7, 111, 1342, 10, 111, 12, 111, 14,
-	111, 1345, 11, 111, 3, 112, 5, 112, 1348, 10, 112, 3, 112, 3, 112, 3, 112,
-	3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 5, 112, 1358, 10, 112, 3, 113,
-	3, 113, 3, 113, 5, 113, 1363, 10, 113, 3, 114, 3, 114, 3, 114, 3, 114,
-	3, 114, 7, 114, 1370, 10, 114, 12, 114, 14, 114, 1373, 11, 114, 3, 114,
-	5, 114, 1376, 10, 114, 3, 114, 3, 114, 5, 114, 1380, 10, 114, 3, 115, 3,
-	115, 3, 115, 3, 116, 3, 116, 3, 116, 3, 116, 3, 116, 3, 116, 5, 116, 1391,
-	10, 116, 3, 117, 3, 117, 3, 117, 3, 117, 3, 117, 3, 118, 3, 118, 3, 119,
-	3, 119, 3, 119, 3, 119, 3, 119, 7, 119, 1405, 10, 119, 12, 119, 14, 119,
-	1408, 11, 119, 3, 119, 3, 119, 5, 119, 1412, 10, 119, 3, 120, 3, 120, 3,
-	121, 3, 121, 3, 121, 5, 121, 1419, 10, 121, 3, 122, 3, 122, 3, 122, 3,
-	122, 5, 122, 1425, 10, 122, 3, 123, 3, 123, 3, 123, 3, 123, 3, 124, 3,
-	124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 124, 7, 124, 1440,
-	10, 124, 12, 124, 14, 124, 1443, 11, 124, 3, 125, 3, 125, 3, 125, 3, 125,
-	3, 125, 7, 125, 1450, 10, 125, 12, 125, 14, 125, 1453, 11, 125, 3, 125,
-	5, 125, 1456, 10, 125, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126,
+	5, 102, 1255, 10, 102, 3, 102, 5, 102, 1258, 10, 102, 3, 102, 5, 102, 1261,
+	10, 102, 3, 102, 5, 102, 1264, 10, 102, 3, 102, 5, 102, 1267, 10, 102,
+	3, 102, 5, 102, 1270, 10, 102, 3, 102, 5, 102, 1273, 10, 102, 3, 103, 3,
+	103, 3, 103, 3, 104, 3, 104, 3, 104, 7, 104, 1281, 10, 104, 12, 104, 14,
+	104, 1284, 11, 104, 3, 105, 3, 105, 5, 105, 1288, 10, 105, 3, 105, 3, 105,
+	3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 6, 105, 1298, 10, 105,
+	13, 105, 14, 105, 1299, 3, 105, 3, 105, 3, 105, 3, 105, 5, 105, 1306, 10,
+	105, 3, 106, 3, 106, 3, 106, 3, 106, 3, 106, 5, 106, 1313, 10, 106, 3,
+	107, 3, 107, 3, 108, 3, 108, 3, 108, 7, 108, 1320, 10, 108, 12, 108, 14,
+	108, 1323, 11, 108, 3, 108, 3, 108, 3, 108, 3, 108, 3, 108, 3, 108, 7,
+	108, 1331, 10, 108, 12, 108, 14, 108, 1334, 11, 108, 5, 108, 1336, 10,
+	108, 3, 108, 3, 108, 5, 108, 1340, 10, 108, 3, 109, 3, 109, 3, 109, 3,
+	109, 3, 110, 3, 110, 3, 110, 3, 111, 3, 111, 3, 111, 3, 111, 3, 111, 3,
+	111, 7, 111, 1355, 10, 111, 12, 111, 14, 111, 1358, 11, 111, 3, 112, 5,
+	112, 1361, 10, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3,
+	112, 3, 112, 5, 112, 1371, 10, 112, 3, 113, 3, 113, 3, 113, 5, 113, 1376,
+	10, 113, 3, 114, 3, 114, 3, 114, 3, 114, 5, 114, 1382, 10, 114, 3, 114,
+	3, 114, 5, 114, 1386, 10, 114, 3, 114, 3, 114, 3, 114, 5, 114, 1391, 10,
+	114, 3, 114, 3, 114, 5, 114, 1395, 10, 114, 7, 114, 1397, 10, 114, 12,
+	114, 14, 114, 1400, 11, 114, 3, 115, 3, 115, 3, 115, 3, 116, 3, 116, 3,
+	116, 3, 116, 3, 116, 3, 116, 5, 116, 1411, 10, 116, 3, 117, 3, 117, 3,
+	117, 3, 117, 3, 117, 3, 118, 3, 118, 3, 119, 3, 119, 3, 119, 3, 119, 3,
+	119, 3, 119, 3, 119, 7, 119, 1427, 10, 119, 12, 119, 14, 119, 1430, 11,
+	119, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 7, 119, 1437, 10, 119, 12,
+	119, 14, 119, 1440, 11, 119, 5, 119, 1442, 10, 119, 3, 119, 3, 119, 5,
+	119, 1446, 10, 119, 3, 120, 3, 120, 3, 121, 3, 121, 3, 121, 5, 121, 1453,
+	10, 121, 3, 122, 3, 122, 3, 122, 3, 122, 5, 122, 1459, 10, 122, 3, 123,
+	3, 123, 3, 123, 3, 123, 3, 124, 3, 124, 3, 124, 5, 124, 1468, 10, 124,
+	3, 124, 3, 124, 3, 124, 5, 124, 1473, 10, 124, 3, 124, 3, 124, 3, 124,
+	3, 124, 7, 124, 1479, 10, 124, 12, 124, 14, 124, 1482, 11, 124, 5, 124,
+	1484, 10, 124, 3, 125, 3, 125, 3, 125, 3, 125, 3, 125, 7, 125, 1491, 10,
+	125, 12, 125, 14, 125, 1494, 11, 125, 3, 125, 5, 125, 1497, 10, 125, 3,
+	125, 5, 125, 1500, 10, 125, 3, 125, 3, 125, 5, 125, 1504, 10, 125, 3, 126,
+	3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126,
 	3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126,
-	3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 3, 126, 5, 126, 1480, 10,
-	126, 3, 127, 3, 127, 3, 127, 2, 4, 162, 220, 128, 2, 4, 6, 8, 10, 12, 14,
-	16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50,
-	52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86,
-	88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118,
-	120, 122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148,
-	150, 152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178,
-	180, 182, 184, 186, 188, 190, 192, 194, 196, 198, 200, 202, 204, 206, 208,
-	210, 212, 214, 216, 218, 220, 222, 224, 226, 228, 230, 232, 234, 236, 238,
-	240, 242, 244, 246, 248, 250, 252, 2, 22, 3, 2, 103, 104, 3, 2, 88, 92,
-	9, 2, 4, 5, 8, 8, 21, 21, 37, 39, 41, 41, 54, 57, 100, 100, 7, 2, 9, 9,
-	17, 17, 23, 23, 30, 31, 33, 33, 4, 2, 20, 20, 42, 42, 3, 2, 107, 111, 3,
-	2, 135, 136, 4, 2, 124, 124, 137, 138, 4, 2, 139, 140, 144, 144, 3, 2,
-	137, 138, 4, 2, 122, 123, 130, 131, 4, 2, 128, 129, 132, 132, 4, 2, 121,
-	121, 145, 155, 3, 2, 93, 94, 7, 2, 3, 3, 73, 73, 86, 86, 121, 123, 130,
-	132, 3, 2, 64, 65, 3, 2, 81, 82, 3, 2, 68, 69, 3, 2, 70, 71, 11, 2, 6,
+	3, 126, 3, 126, 3, 126, 5, 126, 1528, 10, 126, 3, 127, 3, 127, 3, 127,
+	2, 4, 162, 220, 128, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28,
+	30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64,
+	66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100,
+	102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130,
+	132, 134, 136, 138, 140, 142, 144, 146, 148, 150, 152, 154, 156, 158, 160,
+	162, 164, 166, 168, 170, 172, 174, 176, 178, 180, 182, 184, 186, 188, 190,
+	192, 194, 196, 198, 200, 202, 204, 206, 208, 210, 212, 214, 216, 218, 220,
+	222, 224, 226, 228, 230, 232, 234, 236, 238, 240, 242, 244, 246, 248, 250,
+	252, 2, 24, 3, 2, 103, 104, 3, 2, 88, 92, 9, 2, 4, 5, 8, 8, 21, 21, 37,
+	39, 41, 41, 54, 57, 100, 100, 7, 2, 9, 9, 17, 17, 23, 23, 30, 31, 33, 33,
+	4, 2, 20, 20, 42, 42, 3, 2, 107, 111, 3, 2, 135, 136, 4, 2, 124, 124, 137,
+	138, 4, 2, 139, 140, 144, 144, 3, 2, 137, 138, 4, 2, 122, 123, 130, 131,
+	4, 2, 128, 129, 132, 132, 4, 2, 121, 121, 145, 155, 3, 2, 93, 94, 7, 2,
+	3, 3, 73, 73, 86, 86, 121, 123, 130, 132, 3, 2, 64, 65, 3, 2, 81, 82, 3,
+	2, 84, 85, 3, 2, 68, 69, 3, 2, 70, 71, 4, 2, 99, 99, 157, 157, 11, 2, 6,
 	7, 68, 68, 72, 72, 76, 78, 83, 83, 87, 87, 96, 99, 106, 106, 157, 157,
-	2, 1603, 2, 254, 3, 2, 2, 2, 4, 280, 3, 2, 2, 2, 6, 282, 3, 2, 2, 2, 8,
+	2, 1663, 2, 254, 3, 2, 2, 2, 4, 280, 3, 2, 2, 2, 6, 282, 3, 2, 2, 2, 8,
 	291, 3, 2, 2, 2, 10, 299, 3, 2, 2, 2, 12, 304, 3, 2, 2, 2, 14, 308, 3,
 	2, 2, 2, 16, 312, 3, 2, 2, 2, 18, 314, 3, 2, 2, 2, 20, 326, 3, 2, 2, 2,
 	22, 344, 3, 2, 2, 2, 24, 355, 3, 2, 2, 2, 26, 365, 3, 2, 2, 2, 28, 372,
@@ -219,24 +226,24 @@ var parserATN = []uint16{
 	751, 3, 2, 2, 2, 120, 757, 3, 2, 2, 2, 122, 845, 3, 2, 2, 2, 124, 850,
 	3, 2, 2, 2, 126, 857, 3, 2, 2, 2, 128, 862, 3, 2, 2, 2, 130, 867, 3, 2,
 	2, 2, 132, 880, 3, 2, 2, 2, 134, 888, 3, 2, 2, 2, 136, 891, 3, 2, 2, 2,
-	138, 898, 3, 2, 2, 2, 140, 913, 3, 2, 2, 2, 142, 927, 3, 2, 2, 2, 144,
-	931, 3, 2, 2, 2, 146, 936, 3, 2, 2, 2, 148, 944, 3, 2, 2, 2, 150, 946,
-	3, 2, 2, 2, 152, 950, 3, 2, 2, 2, 154, 958, 3, 2, 2, 2, 156, 960, 3, 2,
-	2, 2, 158, 968, 3, 2, 2, 2, 160, 970, 3, 2, 2, 2, 162, 985, 3, 2, 2, 2,
-	164, 1085, 3, 2, 2, 2, 166, 1098, 3, 2, 2, 2, 168, 1117, 3, 2, 2, 2, 170,
-	1119, 3, 2, 2, 2, 172, 1152, 3, 2, 2, 2, 174, 1172, 3, 2, 2, 2, 176, 1176,
-	3, 2, 2, 2, 178, 1180, 3, 2, 2, 2, 180, 1182, 3, 2, 2, 2, 182, 1195, 3,
-	2, 2, 2, 184, 1197, 3, 2, 2, 2, 186, 1201, 3, 2, 2, 2, 188, 1204, 3, 2,
-	2, 2, 190, 1211, 3, 2, 2, 2, 192, 1216, 3, 2, 2, 2, 194, 1224, 3, 2, 2,
-	2, 196, 1231, 3, 2, 2, 2, 198, 1233, 3, 2, 2, 2, 200, 1239, 3, 2, 2, 2,
-	202, 1243, 3, 2, 2, 2, 204, 1266, 3, 2, 2, 2, 206, 1269, 3, 2, 2, 2, 208,
-	1294, 3, 2, 2, 2, 210, 1296, 3, 2, 2, 2, 212, 1303, 3, 2, 2, 2, 214, 1328,
-	3, 2, 2, 2, 216, 1330, 3, 2, 2, 2, 218, 1332, 3, 2, 2, 2, 220, 1335, 3,
-	2, 2, 2, 222, 1357, 3, 2, 2, 2, 224, 1359, 3, 2, 2, 2, 226, 1364, 3, 2,
-	2, 2, 228, 1381, 3, 2, 2, 2, 230, 1390, 3, 2, 2, 2, 232, 1392, 3, 2, 2,
-	2, 234, 1397, 3, 2, 2, 2, 236, 1399, 3, 2, 2, 2, 238, 1413, 3, 2, 2, 2,
-	240, 1415, 3, 2, 2, 2, 242, 1420, 3, 2, 2, 2, 244, 1426, 3, 2, 2, 2, 246,
-	1430, 3, 2, 2, 2, 248, 1444, 3, 2, 2, 2, 250, 1479, 3, 2, 2, 2, 252, 1481,
+	138, 898, 3, 2, 2, 2, 140, 921, 3, 2, 2, 2, 142, 935, 3, 2, 2, 2, 144,
+	939, 3, 2, 2, 2, 146, 944, 3, 2, 2, 2, 148, 952, 3, 2, 2, 2, 150, 954,
+	3, 2, 2, 2, 152, 958, 3, 2, 2, 2, 154, 966, 3, 2, 2, 2, 156, 968, 3, 2,
+	2, 2, 158, 976, 3, 2, 2, 2, 160, 978, 3, 2, 2, 2, 162, 993, 3, 2, 2, 2,
+	164, 1093, 3, 2, 2, 2, 166, 1106, 3, 2, 2, 2, 168, 1125, 3, 2, 2, 2, 170,
+	1127, 3, 2, 2, 2, 172, 1160, 3, 2, 2, 2, 174, 1180, 3, 2, 2, 2, 176, 1184,
+	3, 2, 2, 2, 178, 1188, 3, 2, 2, 2, 180, 1190, 3, 2, 2, 2, 182, 1203, 3,
+	2, 2, 2, 184, 1205, 3, 2, 2, 2, 186, 1209, 3, 2, 2, 2, 188, 1212, 3, 2,
+	2, 2, 190, 1219, 3, 2, 2, 2, 192, 1224, 3, 2, 2, 2, 194, 1232, 3, 2, 2,
+	2, 196, 1239, 3, 2, 2, 2, 198, 1241, 3, 2, 2, 2, 200, 1247, 3, 2, 2, 2,
+	202, 1251, 3, 2, 2, 2, 204, 1274, 3, 2, 2, 2, 206, 1277, 3, 2, 2, 2, 208,
+	1305, 3, 2, 2, 2, 210, 1307, 3, 2, 2, 2, 212, 1314, 3, 2, 2, 2, 214, 1339,
+	3, 2, 2, 2, 216, 1341, 3, 2, 2, 2, 218, 1345, 3, 2, 2, 2, 220, 1348, 3,
+	2, 2, 2, 222, 1370, 3, 2, 2, 2, 224, 1372, 3, 2, 2, 2, 226, 1377, 3, 2,
+	2, 2, 228, 1401, 3, 2, 2, 2, 230, 1410, 3, 2, 2, 2, 232, 1412, 3, 2, 2,
+	2, 234, 1417, 3, 2, 2, 2, 236, 1419, 3, 2, 2, 2, 238, 1447, 3, 2, 2, 2,
+	240, 1449, 3, 2, 2, 2, 242, 1454, 3, 2, 2, 2, 244, 1460, 3, 2, 2, 2, 246,
+	1464, 3, 2, 2, 2, 248, 1485, 3, 2, 2, 2, 250, 1527, 3, 2, 2, 2, 252, 1529,
 	3, 2, 2, 2, 254, 255, 5, 4, 3, 2, 255, 256, 7, 2, 2, 3, 256, 3, 3, 2, 2,
 	2, 257, 259, 5, 14, 8, 2, 258, 257, 3, 2, 2, 2, 259, 262, 3, 2, 2, 2, 260,
 	258, 3, 2, 2, 2, 260, 261, 3, 2, 2, 2, 261, 263, 3, 2, 2, 2, 262, 260,
@@ -481,257 +488,278 @@ var parserATN = []uint16{
 	2, 900, 901, 5, 114, 58, 2, 901, 139, 3, 2, 2, 2, 902, 907, 5, 100, 51,
 	2, 903, 904, 7, 119, 2, 2, 904, 906, 5, 100, 51, 2, 905, 903, 3, 2, 2,
 	2, 906, 909, 3, 2, 2, 2, 907, 905, 3, 2, 2, 2, 907, 908, 3, 2, 2, 2, 908,
-	914, 3, 2, 2, 2, 909, 907, 3, 2, 2, 2, 910, 911, 5, 72, 37, 2, 911, 912,
-	5, 250, 126, 2, 912, 914, 3, 2, 2, 2, 913, 902, 3, 2, 2, 2, 913, 910, 3,
-	2, 2, 2, 914, 141, 3, 2, 2, 2, 915, 928, 5, 146, 74, 2, 916, 918, 5, 144,
-	73, 2, 917, 916, 3, 2, 2, 2, 917, 918, 3, 2, 2, 2, 918, 919, 3, 2, 2, 2,
-	919, 921, 7, 118, 2, 2, 920, 922, 5, 162, 82, 2, 921, 920, 3, 2, 2, 2,
-	921, 922, 3, 2, 2, 2, 922, 923, 3, 2, 2, 2, 923, 925, 7, 118, 2, 2, 924,
-	926, 5, 148, 75, 2, 925, 924, 3, 2, 2, 2, 925, 926, 3, 2, 2, 2, 926, 928,
-	3, 2, 2, 2, 927, 915, 3, 2, 2, 2, 927, 917, 3, 2, 2, 2, 928, 143, 3, 2,
-	2, 2, 929, 932, 5, 120, 61, 2, 930, 932, 5, 152, 77, 2, 931, 929, 3, 2,
-	2, 2, 931, 930, 3, 2, 2, 2, 932, 145, 3, 2, 2, 2, 933, 935, 5, 16, 9, 2,
-	934, 933, 3, 2, 2, 2, 935, 938, 3, 2, 2, 2, 936, 934, 3, 2, 2, 2, 936,
-	937, 3, 2, 2, 2, 937, 939, 3, 2, 2, 2, 938, 936, 3, 2, 2, 2, 939, 940,
-	5, 72, 37, 2, 940, 941, 5, 64, 33, 2, 941, 942, 7, 127, 2, 2, 942, 943,
-	5, 162, 82, 2, 943, 147, 3, 2, 2, 2, 944, 945, 5, 152, 77, 2, 945, 149,
-	3, 2, 2, 2, 946, 947, 7, 112, 2, 2, 947, 948, 5, 162, 82, 2, 948, 949,
-	7, 113, 2, 2, 949, 151, 3, 2, 2, 2, 950, 955, 5, 162, 82, 2, 951, 952,
-	7, 119, 2, 2, 952, 954, 5, 162, 82, 2, 953, 951, 3, 2, 2, 2, 954, 957,
-	3, 2, 2, 2, 955, 953, 3, 2, 2, 2, 955, 956, 3, 2, 2, 2, 956, 153, 3, 2,
-	2, 2, 957, 955, 3, 2, 2, 2, 958, 959, 5, 162, 82, 2, 959, 155, 3, 2, 2,
-	2, 960, 961, 5, 162, 82, 2, 961, 157, 3, 2, 2, 2, 962, 963, 9, 3, 2, 2,
-	963, 969, 5, 162, 82, 2, 964, 965, 7, 89, 2, 2, 965, 966, 5, 162, 82, 2,
-	966, 967, 5, 250, 126, 2, 967, 969, 3, 2, 2, 2, 968, 962, 3, 2, 2, 2, 968,
-	964, 3, 2, 2, 2, 969, 159, 3, 2, 2, 2, 970, 971, 5, 158, 80, 2, 971, 161,
-	3, 2, 2, 2, 972, 973, 8, 82, 1, 2, 973, 986, 5, 164, 83, 2, 974, 975, 7,
-	35, 2, 2, 975, 986, 5, 166, 84, 2, 976, 977, 7, 112, 2, 2, 977, 978, 5,
-	72, 37, 2, 978, 979, 7, 113, 2, 2, 979, 980, 5, 162, 82, 19, 980, 986,
-	3, 2, 2, 2, 981, 982, 9, 8, 2, 2, 982, 986, 5, 162, 82, 17, 983, 984, 9,
-	9, 2, 2, 984, 986, 5, 162, 82, 16, 985, 972, 3, 2, 2, 2, 985, 974, 3, 2,
-	2, 2, 985, 976, 3, 2, 2, 2, 985, 981, 3, 2, 2, 2, 985, 983, 3, 2, 2, 2,
-	986, 1058, 3, 2, 2, 2, 987, 988, 12, 15, 2, 2, 988, 989, 9, 10, 2, 2, 989,
-	1057, 5, 162, 82, 16, 990, 991, 12, 14, 2, 2, 991, 992, 9, 11, 2, 2, 992,
-	1057, 5, 162, 82, 15, 993, 1001, 12, 13, 2, 2, 994, 995, 7, 123, 2, 2,
-	995, 1002, 7, 123, 2, 2, 996, 997, 7, 122, 2, 2, 997, 998, 7, 122, 2, 2,
-	998, 1002, 7, 122, 2, 2, 999, 1000, 7, 122, 2, 2, 1000, 1002, 7, 122, 2,
-	2, 1001, 994, 3, 2, 2, 2, 1001, 996, 3, 2, 2, 2, 1001, 999, 3, 2, 2, 2,
-	1002, 1003, 3, 2, 2, 2, 1003, 1057, 5, 162, 82, 14, 1004, 1005, 12, 12,
-	2, 2, 1005, 1006, 9, 12, 2, 2, 1006, 1057, 5, 162, 82, 13, 1007, 1008,
-	12, 10, 2, 2, 1008, 1009, 9, 13, 2, 2, 1009, 1057, 5, 162, 82, 11, 1010,
-	1011, 12, 9, 2, 2, 1011, 1012, 7, 141, 2, 2, 1012, 1057, 5, 162, 82, 10,
-	1013, 1014, 12, 8, 2, 2, 1014, 1015, 7, 143, 2, 2, 1015, 1057, 5, 162,
-	82, 9, 1016, 1017, 12, 7, 2, 2, 1017, 1018, 7, 142, 2, 2, 1018, 1057, 5,
-	162, 82, 8, 1019, 1020, 12, 6, 2, 2, 1020, 1021, 7, 133, 2, 2, 1021, 1057,
-	5, 162, 82, 7, 1022, 1023, 12, 5, 2, 2, 1023, 1024, 7, 134, 2, 2, 1024,
-	1057, 5, 162, 82, 6, 1025, 1026, 12, 4, 2, 2, 1026, 1027, 7, 126, 2, 2,
-	1027, 1028, 5, 162, 82, 2, 1028, 1029, 7, 127, 2, 2, 1029, 1030, 5, 162,
-	82, 5, 1030, 1057, 3, 2, 2, 2, 1031, 1032, 12, 3, 2, 2, 1032, 1033, 9,
-	14, 2, 2, 1033, 1057, 5, 162, 82, 3, 1034, 1035, 12, 24, 2, 2, 1035, 1036,
-	7, 120, 2, 2, 1036, 1057, 5, 250, 126, 2, 1037, 1038, 12, 23, 2, 2, 1038,
-	1039, 7, 120, 2, 2, 1039, 1057, 5, 186, 94, 2, 1040, 1041, 12, 22, 2, 2,
-	1041, 1042, 7, 116, 2, 2, 1042, 1043, 5, 162, 82, 2, 1043, 1044, 7, 117,
-	2, 2, 1044, 1057, 3, 2, 2, 2, 1045, 1046, 12, 21, 2, 2, 1046, 1048, 7,
-	112, 2, 2, 1047, 1049, 5, 152, 77, 2, 1048, 1047, 3, 2, 2, 2, 1048, 1049,
-	3, 2, 2, 2, 1049, 1050, 3, 2, 2, 2, 1050, 1057, 7, 113, 2, 2, 1051, 1052,
-	12, 18, 2, 2, 1052, 1057, 9, 8, 2, 2, 1053, 1054, 12, 11, 2, 2, 1054, 1055,
-	7, 29, 2, 2, 1055, 1057, 5, 72, 37, 2, 1056, 987, 3, 2, 2, 2, 1056, 990,
-	3, 2, 2, 2, 1056, 993, 3, 2, 2, 2, 1056, 1004, 3, 2, 2, 2, 1056, 1007,
-	3, 2, 2, 2, 1056, 1010, 3, 2, 2, 2, 1056, 1013, 3, 2, 2, 2, 1056, 1016,
-	3, 2, 2, 2, 1056, 1019, 3, 2, 2, 2, 1056, 1022, 3, 2, 2, 2, 1056, 1025,
-	3, 2, 2, 2, 1056, 1031, 3, 2, 2, 2, 1056, 1034, 3, 2, 2, 2, 1056, 1037,
-	3, 2, 2, 2, 1056, 1040, 3, 2, 2, 2, 1056, 1045, 3, 2, 2, 2, 1056, 1051,
-	3, 2, 2, 2, 1056, 1053, 3, 2, 2, 2, 1057, 1060, 3, 2, 2, 2, 1058, 1056,
-	3, 2, 2, 2, 1058, 1059, 3, 2, 2, 2, 1059, 163, 3, 2, 2, 2, 1060, 1058,
-	3, 2, 2, 2, 1061, 1062, 7, 112, 2, 2, 1062, 1063, 5, 162, 82, 2, 1063,
-	1064, 7, 113, 2, 2, 1064, 1086, 3, 2, 2, 2, 1065, 1086, 7, 44, 2, 2, 1066,
-	1086, 7, 42, 2, 2, 1067, 1086, 5, 100, 51, 2, 1068, 1086, 5, 250, 126,
-	2, 1069, 1070, 5, 72, 37, 2, 1070, 1071, 7, 120, 2, 2, 1071, 1072, 7, 12,
-	2, 2, 1072, 1086, 3, 2, 2, 2, 1073, 1074, 7, 49, 2, 2, 1074, 1075, 7, 120,
-	2, 2, 1075, 1086, 7, 12, 2, 2, 1076, 1080, 5, 188, 95, 2, 1077, 1081, 5,
-	196, 99, 2, 1078, 1079, 7, 44, 2, 2, 1079, 1081, 5, 198, 100, 2, 1080,
-	1077, 3, 2, 2, 2, 1080, 1078, 3, 2, 2, 2, 1081, 1086, 3, 2, 2, 2, 1082,
-	1086, 5, 200, 101, 2, 1083, 1086, 5, 244, 123, 2, 1084, 1086, 5, 78, 40,
-	2, 1085, 1061, 3, 2, 2, 2, 1085, 1065, 3, 2, 2, 2, 1085, 1066, 3, 2, 2,
-	2, 1085, 1067, 3, 2, 2, 2, 1085, 1068, 3, 2, 2, 2, 1085, 1069, 3, 2, 2,
-	2, 1085, 1073, 3, 2, 2, 2, 1085, 1076, 3, 2, 2, 2, 1085, 1082, 3, 2, 2,
-	2, 1085, 1083, 3, 2, 2, 2, 1085, 1084, 3, 2, 2, 2, 1086, 165, 3, 2, 2,
-	2, 1087, 1088, 5, 188, 95, 2, 1088, 1089, 5, 168, 85, 2, 1089, 1090, 5,
-	184, 93, 2, 1090, 1099, 3, 2, 2, 2, 1091, 1096, 5, 168, 85, 2, 1092, 1097,
-	5, 172, 87, 2, 1093, 1097, 5, 184, 93, 2, 1094, 1097, 5, 174, 88, 2, 1095,
-	1097, 5, 180, 91, 2, 1096, 1092, 3, 2, 2, 2, 1096, 1093, 3, 2, 2, 2, 1096,
-	1094, 3, 2, 2, 2, 1096, 1095, 3, 2, 2, 2, 1097, 1099, 3, 2, 2, 2, 1098,
-	1087, 3, 2, 2, 2, 1098, 1091, 3, 2, 2, 2, 1099, 167, 3, 2, 2, 2, 1100,
-	1102, 5, 250, 126, 2, 1101, 1103, 5, 190, 96, 2, 1102, 1101, 3, 2, 2, 2,
-	1102, 1103, 3, 2, 2, 2, 1103, 1111, 3, 2, 2, 2, 1104, 1105, 7, 120, 2,
-	2, 1105, 1107, 5, 250, 126, 2, 1106, 1108, 5, 190, 96, 2, 1107, 1106, 3,
-	2, 2, 2, 1107, 1108, 3, 2, 2, 2, 1108, 1110, 3, 2, 2, 2, 1109, 1104, 3,
-	2, 2, 2, 1110, 1113, 3, 2, 2, 2, 1111, 1109, 3, 2, 2, 2, 1111, 1112, 3,
-	2, 2, 2, 1112, 1118, 3, 2, 2, 2, 1113, 1111, 3, 2, 2, 2, 1114, 1118, 5,
-	78, 40, 2, 1115, 1116, 7, 6, 2, 2, 1116, 1118, 5, 190, 96, 2, 1117, 1100,
-	3, 2, 2, 2, 1117, 1114, 3, 2, 2, 2, 1117, 1115, 3, 2, 2, 2, 1118, 169,
-	3, 2, 2, 2, 1119, 1121, 5, 250, 126, 2, 1120, 1122, 5, 192, 97, 2, 1121,
-	1120, 3, 2, 2, 2, 1121, 1122, 3, 2, 2, 2, 1122, 1123, 3, 2, 2, 2, 1123,
-	1124, 5, 184, 93, 2, 1124, 171, 3, 2, 2, 2, 1125, 1129, 5, 74, 38, 2, 1126,
-	1128, 5, 74, 38, 2, 1127, 1126, 3, 2, 2, 2, 1128, 1131, 3, 2, 2, 2, 1129,
-	1127, 3, 2, 2, 2, 1129, 1130, 3, 2, 2, 2, 1130, 1132, 3, 2, 2, 2, 1131,
-	1129, 3, 2, 2, 2, 1132, 1133, 5, 68, 35, 2, 1133, 1153, 3, 2, 2, 2, 1134,
-	1135, 7, 116, 2, 2, 1135, 1136, 5, 162, 82, 2, 1136, 1143, 7, 117, 2, 2,
-	1137, 1138, 7, 116, 2, 2, 1138, 1139, 5, 162, 82, 2, 1139, 1140, 7, 117,
-	2, 2, 1140, 1142, 3, 2, 2, 2, 1141, 1137, 3, 2, 2, 2, 1142, 1145, 3, 2,
-	2, 2, 1143, 1141, 3, 2, 2, 2, 1143, 1144, 3, 2, 2, 2, 1144, 1149, 3, 2,
-	2, 2, 1145, 1143, 3, 2, 2, 2, 1146, 1148, 5, 74, 38, 2, 1147, 1146, 3,
-	2, 2, 2, 1148, 1151, 3, 2, 2, 2, 1149, 1147, 3, 2, 2, 2, 1149, 1150, 3,
-	2, 2, 2, 1150, 1153, 3, 2, 2, 2, 1151, 1149, 3, 2, 2, 2, 1152, 1125, 3,
-	2, 2, 2, 1152, 1134, 3, 2, 2, 2, 1153, 173, 3, 2, 2, 2, 1154, 1155, 7,
-	114, 2, 2, 1155, 1173, 7, 115, 2, 2, 1156, 1157, 7, 114, 2, 2, 1157, 1158,
-	5, 176, 89, 2, 1158, 1159, 7, 156, 2, 2, 1159, 1167, 5, 178, 90, 2, 1160,
-	1161, 7, 119, 2, 2, 1161, 1162, 5, 176, 89, 2, 1162, 1163, 7, 156, 2, 2,
-	1163, 1164, 5, 178, 90, 2, 1164, 1166, 3, 2, 2, 2, 1165, 1160, 3, 2, 2,
-	2, 1166, 1169, 3, 2, 2, 2, 1167, 1165, 3, 2, 2, 2, 1167, 1168, 3, 2, 2,
-	2, 1168, 1170, 3, 2, 2, 2, 1169, 1167, 3, 2, 2, 2, 1170, 1171, 7, 115,
-	2, 2, 1171, 1173, 3, 2, 2, 2, 1172, 1154, 3, 2, 2, 2, 1172, 1156, 3, 2,
-	2, 2, 1173, 175, 3, 2, 2, 2, 1174, 1177, 5, 250, 126, 2, 1175, 1177, 5,
-	162, 82, 2, 1176, 1174, 3, 2, 2, 2, 1176, 1175, 3, 2, 2, 2, 1177, 177,
-	3, 2, 2, 2, 1178, 1181, 5, 100, 51, 2, 1179, 1181, 5, 162, 82, 2, 1180,
-	1178, 3, 2, 2, 2, 1180, 1179, 3, 2, 2, 2, 1181, 179, 3, 2, 2, 2, 1182,
-	1183, 7, 114, 2, 2, 1183, 1188, 5, 182, 92, 2, 1184, 1185, 7, 119, 2, 2,
-	1185, 1187, 5, 182, 92, 2, 1186, 1184, 3, 2, 2, 2, 1187, 1190, 3, 2, 2,
-	2, 1188, 1186, 3, 2, 2, 2, 1188, 1189, 3, 2, 2, 2, 1189, 1191, 3, 2, 2,
-	2, 1190, 1188, 3, 2, 2, 2, 1191, 1192, 7, 115, 2, 2, 1192, 181, 3, 2, 2,
-	2, 1193, 1196, 5, 100, 51, 2, 1194, 1196, 5, 162, 82, 2, 1195, 1193, 3,
-	2, 2, 2, 1195, 1194, 3, 2, 2, 2, 1196, 183, 3, 2, 2, 2, 1197, 1199, 5,
-	198, 100, 2, 1198, 1200, 5, 32, 17, 2, 1199, 1198, 3, 2, 2, 2, 1199, 1200,
-	3, 2, 2, 2, 1200, 185, 3, 2, 2, 2, 1201, 1202, 5, 188, 95, 2, 1202, 1203,
-	5, 196, 99, 2, 1203, 187, 3, 2, 2, 2, 1204, 1205, 7, 123, 2, 2, 1205, 1206,
-	5, 30, 16, 2, 1206, 1207, 7, 122, 2, 2, 1207, 189, 3, 2, 2, 2, 1208, 1209,
-	7, 123, 2, 2, 1209, 1212, 7, 122, 2, 2, 1210, 1212, 5, 80, 41, 2, 1211,
-	1208, 3, 2, 2, 2, 1211, 1210, 3, 2, 2, 2, 1212, 191, 3, 2, 2, 2, 1213,
-	1214, 7, 123, 2, 2, 1214, 1217, 7, 122, 2, 2, 1215, 1217, 5, 188, 95, 2,
-	1216, 1213, 3, 2, 2, 2, 1216, 1215, 3, 2, 2, 2, 1217, 193, 3, 2, 2, 2,
-	1218, 1225, 5, 198, 100, 2, 1219, 1220, 7, 120, 2, 2, 1220, 1222, 5, 250,
-	126, 2, 1221, 1223, 5, 198, 100, 2, 1222, 1221, 3, 2, 2, 2, 1222, 1223,
-	3, 2, 2, 2, 1223, 1225, 3, 2, 2, 2, 1224, 1218, 3, 2, 2, 2, 1224, 1219,
-	3, 2, 2, 2, 1225, 195, 3, 2, 2, 2, 1226, 1227, 7, 42, 2, 2, 1227, 1232,
-	5, 194, 98, 2, 1228, 1229, 5, 250, 126, 2, 1229, 1230, 5, 198, 100, 2,
-	1230, 1232, 3, 2, 2, 2, 1231, 1226, 3, 2, 2, 2, 1231, 1228, 3, 2, 2, 2,
-	1232, 197, 3, 2, 2, 2, 1233, 1235, 7, 112, 2, 2, 1234, 1236, 5, 152, 77,
-	2, 1235, 1234, 3, 2, 2, 2, 1235, 1236, 3, 2, 2, 2, 1236, 1237, 3, 2, 2,
-	2, 1237, 1238, 7, 113, 2, 2, 1238, 199, 3, 2, 2, 2, 1239, 1240, 7, 116,
-	2, 2, 1240, 1241, 5, 202, 102, 2, 1241, 1242, 7, 117, 2, 2, 1242, 201,
-	3, 2, 2, 2, 1243, 1244, 5, 204, 103, 2, 1244, 1246, 5, 210, 106, 2, 1245,
-	1247, 5, 218, 110, 2, 1246, 1245, 3, 2, 2, 2, 1246, 1247, 3, 2, 2, 2, 1247,
-	1249, 3, 2, 2, 2, 1248, 1250, 5, 232, 117, 2, 1249, 1248, 3, 2, 2, 2, 1249,
-	1250, 3, 2, 2, 2, 1250, 1252, 3, 2, 2, 2, 1251, 1253, 5, 236, 119, 2, 1252,
-	1251, 3, 2, 2, 2, 1252, 1253, 3, 2, 2, 2, 1253, 1255, 3, 2, 2, 2, 1254,
-	1256, 5, 226, 114, 2, 1255, 1254, 3, 2, 2, 2, 1255, 1256, 3, 2, 2, 2, 1256,
-	1258, 3, 2, 2, 2, 1257, 1259, 5, 224, 113, 2, 1258, 1257, 3, 2, 2, 2, 1258,
-	1259, 3, 2, 2, 2, 1259, 1261, 3, 2, 2, 2, 1260, 1262, 5, 240, 121, 2, 1261,
-	1260, 3, 2, 2, 2, 1261, 1262, 3, 2, 2, 2, 1262, 1264, 3, 2, 2, 2, 1263,
-	1265, 5, 242, 122, 2, 1264, 1263, 3, 2, 2, 2, 1264, 1265, 3, 2, 2, 2, 1265,
-	203, 3, 2, 2, 2, 1266, 1267, 7, 58, 2, 2, 1267, 1268, 5, 206, 104, 2, 1268,
-	205, 3, 2, 2, 2, 1269, 1274, 5, 208, 105, 2, 1270, 1271, 7, 119, 2, 2,
-	1271, 1273, 5, 208, 105, 2, 1272, 1270, 3, 2, 2, 2, 1273, 1276, 3, 2, 2,
-	2, 1274, 1272, 3, 2, 2, 2, 1274, 1275, 3, 2, 2, 2, 1275, 207, 3, 2, 2,
-	2, 1276, 1274, 3, 2, 2, 2, 1277, 1295, 5, 214, 108, 2, 1278, 1295, 5, 216,
-	109, 2, 1279, 1280, 7, 67, 2, 2, 1280, 1286, 5, 214, 108, 2, 1281, 1282,
-	7, 53, 2, 2, 1282, 1283, 5, 250, 126, 2, 1283, 1284, 7, 87, 2, 2, 1284,
-	1285, 5, 206, 104, 2, 1285, 1287, 3, 2, 2, 2, 1286, 1281, 3, 2, 2, 2, 1287,
-	1288, 3, 2, 2, 2, 1288, 1286, 3, 2, 2, 2, 1288, 1289, 3, 2, 2, 2, 1289,
-	1290, 3, 2, 2, 2, 1290, 1291, 7, 18, 2, 2, 1291, 1292, 5, 206, 104, 2,
-	1292, 1293, 7, 74, 2, 2, 1293, 1295, 3, 2, 2, 2, 1294, 1277, 3, 2, 2, 2,
-	1294, 1278, 3, 2, 2, 2, 1294, 1279, 3, 2, 2, 2, 1295, 209, 3, 2, 2, 2,
-	1296, 1297, 7, 59, 2, 2, 1297, 1301, 5, 250, 126, 2, 1298, 1299, 7, 75,
-	2, 2, 1299, 1300, 7, 83, 2, 2, 1300, 1302, 5, 212, 107, 2, 1301, 1298,
-	3, 2, 2, 2, 1301, 1302, 3, 2, 2, 2, 1302, 211, 3, 2, 2, 2, 1303, 1304,
-	3, 2, 2, 2, 1304, 213, 3, 2, 2, 2, 1305, 1306, 5, 250, 126, 2, 1306, 1307,
-	7, 120, 2, 2, 1307, 1309, 3, 2, 2, 2, 1308, 1305, 3, 2, 2, 2, 1309, 1312,
-	3, 2, 2, 2, 1310, 1308, 3, 2, 2, 2, 1310, 1311, 3, 2, 2, 2, 1311, 1313,
-	3, 2, 2, 2, 1312, 1310, 3, 2, 2, 2, 1313, 1329, 5, 250, 126, 2, 1314, 1315,
-	5, 250, 126, 2, 1315, 1324, 7, 112, 2, 2, 1316, 1321, 5, 214, 108, 2, 1317,
-	1318, 7, 119, 2, 2, 1318, 1320, 5, 214, 108, 2, 1319, 1317, 3, 2, 2, 2,
-	1320, 1323, 3, 2, 2, 2, 1321, 1319, 3, 2, 2, 2, 1321, 1322, 3, 2, 2, 2,
-	1322, 1325, 3, 2, 2, 2, 1323, 1321, 3, 2, 2, 2, 1324, 1316, 3, 2, 2, 2,
-	1324, 1325, 3, 2, 2, 2, 1325, 1326, 3, 2, 2, 2, 1326, 1327, 7, 113, 2,
-	2, 1327, 1329, 3, 2, 2, 2, 1328, 1310, 3, 2, 2, 2, 1328, 1314, 3, 2, 2,
-	2, 1329, 215, 3, 2, 2, 2, 1330, 1331, 5, 202, 102, 2, 1331, 217, 3, 2,
-	2, 2, 1332, 1333, 7, 60, 2, 2, 1333, 1334, 5, 220, 111, 2, 1334, 219, 3,
-	2, 2, 2, 1335, 1336, 8, 111, 1, 2, 1336, 1337, 5, 222, 112, 2, 1337, 1343,
-	3, 2, 2, 2, 1338, 1339, 12, 3, 2, 2, 1339, 1340, 9, 15, 2, 2, 1340, 1342,
-	5, 220, 111, 4, 1341, 1338, 3, 2, 2, 2, 1342, 1345, 3, 2, 2, 2, 1343, 1341,
-	3, 2, 2, 2, 1343, 1344, 3, 2, 2, 2, 1344, 221, 3, 2, 2, 2, 1345, 1343,
-	3, 2, 2, 2, 1346, 1348, 7, 95, 2, 2, 1347, 1346, 3, 2, 2, 2, 1347, 1348,
-	3, 2, 2, 2, 1348, 1349, 3, 2, 2, 2, 1349, 1350, 5, 214, 108, 2, 1350, 1351,
-	9, 16, 2, 2, 1351, 1352, 5, 230, 116, 2, 1352, 1358, 3, 2, 2, 2, 1353,
-	1354, 7, 112, 2, 2, 1354, 1355, 5, 220, 111, 2, 1355, 1356, 7, 113, 2,
-	2, 1356, 1358, 3, 2, 2, 2, 1357, 1347, 3, 2, 2, 2, 1357, 1353, 3, 2, 2,
-	2, 1358, 223, 3, 2, 2, 2, 1359, 1362, 7, 61, 2, 2, 1360, 1363, 7, 107,
-	2, 2, 1361, 1363, 5, 228, 115, 2, 1362, 1360, 3, 2, 2, 2, 1362, 1361, 3,
-	2, 2, 2, 1363, 225, 3, 2, 2, 2, 1364, 1365, 7, 62, 2, 2, 1365, 1366, 7,
-	63, 2, 2, 1366, 1371, 5, 214, 108, 2, 1367, 1368, 7, 119, 2, 2, 1368, 1370,
-	5, 214, 108, 2, 1369, 1367, 3, 2, 2, 2, 1370, 1373, 3, 2, 2, 2, 1371, 1369,
-	3, 2, 2, 2, 1371, 1372, 3, 2, 2, 2, 1372, 1375, 3, 2, 2, 2, 1373, 1371,
-	3, 2, 2, 2, 1374, 1376, 9, 17, 2, 2, 1375, 1374, 3, 2, 2, 2, 1375, 1376,
-	3, 2, 2, 2, 1376, 1379, 3, 2, 2, 2, 1377, 1378, 7, 80, 2, 2, 1378, 1380,
-	9, 18, 2, 2, 1379, 1377, 3, 2, 2, 2, 1379, 1380, 3, 2, 2, 2, 1380, 227,
-	3, 2, 2, 2, 1381, 1382, 7, 127, 2, 2, 1382, 1383, 5, 162, 82, 2, 1383,
-	229, 3, 2, 2, 2, 1384, 1391, 5, 100, 51, 2, 1385, 1391, 5, 228, 115, 2,
-	1386, 1387, 5, 250, 126, 2, 1387, 1388, 7, 127, 2, 2, 1388, 1389, 5, 100,
-	51, 2, 1389, 1391, 3, 2, 2, 2, 1390, 1384, 3, 2, 2, 2, 1390, 1385, 3, 2,
-	2, 2, 1390, 1386, 3, 2, 2, 2, 1391, 231, 3, 2, 2, 2, 1392, 1393, 7, 66,
-	2, 2, 1393, 1394, 7, 76, 2, 2, 1394, 1395, 7, 77, 2, 2, 1395, 1396, 5,
-	234, 118, 2, 1396, 233, 3, 2, 2, 2, 1397, 1398, 3, 2, 2, 2, 1398, 235,
-	3, 2, 2, 2, 1399, 1400, 7, 78, 2, 2, 1400, 1401, 7, 63, 2, 2, 1401, 1406,
-	5, 214, 108, 2, 1402, 1403, 7, 119, 2, 2, 1403, 1405, 5, 214, 108, 2, 1404,
-	1402, 3, 2, 2, 2, 1405, 1408, 3, 2, 2, 2, 1406, 1404, 3, 2, 2, 2, 1406,
-	1407, 3, 2, 2, 2, 1407, 1411, 3, 2, 2, 2, 1408, 1406, 3, 2, 2, 2, 1409,
-	1410, 7, 79, 2, 2, 1410, 1412, 5, 238, 120, 2, 1411, 1409, 3, 2, 2, 2,
-	1411, 1412, 3, 2, 2, 2, 1412, 237, 3, 2, 2, 2, 1413, 1414, 5, 220, 111,
-	2, 1414, 239, 3, 2, 2, 2, 1415, 1418, 7, 72, 2, 2, 1416, 1419, 7, 107,
-	2, 2, 1417, 1419, 5, 228, 115, 2, 1418, 1416, 3, 2, 2, 2, 1418, 1417, 3,
-	2, 2, 2, 1419, 241, 3, 2, 2, 2, 1420, 1421, 7, 24, 2, 2, 1421, 1424, 9,
-	19, 2, 2, 1422, 1423, 7, 90, 2, 2, 1423, 1425, 9, 20, 2, 2, 1424, 1422,
-	3, 2, 2, 2, 1424, 1425, 3, 2, 2, 2, 1425, 243, 3, 2, 2, 2, 1426, 1427,
-	7, 116, 2, 2, 1427, 1428, 5, 246, 124, 2, 1428, 1429, 7, 117, 2, 2, 1429,
-	245, 3, 2, 2, 2, 1430, 1431, 7, 96, 2, 2, 1431, 1432, 5, 100, 51, 2, 1432,
-	1433, 7, 73, 2, 2, 1433, 1434, 7, 99, 2, 2, 1434, 1435, 7, 97, 2, 2, 1435,
-	1436, 7, 98, 2, 2, 1436, 1441, 5, 248, 125, 2, 1437, 1438, 7, 119, 2, 2,
-	1438, 1440, 5, 248, 125, 2, 1439, 1437, 3, 2, 2, 2, 1440, 1443, 3, 2, 2,
-	2, 1441, 1439, 3, 2, 2, 2, 1441, 1442, 3, 2, 2, 2, 1442, 247, 3, 2, 2,
-	2, 1443, 1441, 3, 2, 2, 2, 1444, 1455, 7, 157, 2, 2, 1445, 1446, 7, 112,
-	2, 2, 1446, 1451, 7, 157, 2, 2, 1447, 1448, 7, 119, 2, 2, 1448, 1450, 7,
-	157, 2, 2, 1449, 1447, 3, 2, 2, 2, 1450, 1453, 3, 2, 2, 2, 1451, 1449,
-	3, 2, 2, 2, 1451, 1452, 3, 2, 2, 2, 1452, 1454, 3, 2, 2, 2, 1453, 1451,
-	3, 2, 2, 2, 1454, 1456, 7, 113, 2, 2, 1455, 1445, 3, 2, 2, 2, 1455, 1456,
-	3, 2, 2, 2, 1456, 249, 3, 2, 2, 2, 1457, 1480, 7, 157, 2, 2, 1458, 1480,
-	7, 7, 2, 2, 1459, 1480, 7, 6, 2, 2, 1460, 1480, 7, 76, 2, 2, 1461, 1480,
-	7, 78, 2, 2, 1462, 1480, 7, 91, 2, 2, 1463, 1480, 7, 88, 2, 2, 1464, 1480,
-	7, 90, 2, 2, 1465, 1480, 7, 92, 2, 2, 1466, 1480, 7, 89, 2, 2, 1467, 1480,
-	7, 83, 2, 2, 1468, 1480, 7, 77, 2, 2, 1469, 1480, 7, 68, 2, 2, 1470, 1480,
-	7, 72, 2, 2, 1471, 1480, 7, 87, 2, 2, 1472, 1480, 7, 96, 2, 2, 1473, 1480,
-	7, 98, 2, 2, 1474, 1480, 7, 99, 2, 2, 1475, 1480, 7, 97, 2, 2, 1476, 1480,
-	7, 105, 2, 2, 1477, 1480, 7, 106, 2, 2, 1478, 1480, 5, 78, 40, 2, 1479,
-	1457, 3, 2, 2, 2, 1479, 1458, 3, 2, 2, 2, 1479, 1459, 3, 2, 2, 2, 1479,
-	1460, 3, 2, 2, 2, 1479, 1461, 3, 2, 2, 2, 1479, 1462, 3, 2, 2, 2, 1479,
-	1463, 3, 2, 2, 2, 1479, 1464, 3, 2, 2, 2, 1479, 1465, 3, 2, 2, 2, 1479,
-	1466, 3, 2, 2, 2, 1479, 1467, 3, 2, 2, 2, 1479, 1468, 3, 2, 2, 2, 1479,
-	1469, 3, 2, 2, 2, 1479, 1470, 3, 2, 2, 2, 1479, 1471, 3, 2, 2, 2, 1479,
-	1472, 3, 2, 2, 2, 1479, 1473, 3, 2, 2, 2, 1479, 1474, 3, 2, 2, 2, 1479,
-	1475, 3, 2, 2, 2, 1479, 1476, 3, 2, 2, 2, 1479, 1477, 3, 2, 2, 2, 1479,
-	1478, 3, 2, 2, 2, 1480, 251, 3, 2, 2, 2, 1481, 1482, 9, 21, 2, 2, 1482,
-	253, 3, 2, 2, 2, 166, 260, 267, 274, 280, 296, 304, 308, 312, 318, 322,
-	330, 334, 337, 340, 349, 355, 360, 363, 369, 381, 388, 397, 404, 410, 414,
-	423, 426, 430, 438, 443, 447, 453, 468, 475, 480, 487, 495, 505, 513, 521,
-	526, 535, 541, 548, 553, 561, 565, 567, 577, 584, 587, 594, 599, 603, 608,
-	618, 627, 629, 636, 641, 650, 655, 658, 663, 672, 688, 698, 701, 709, 719,
-	727, 730, 733, 741, 749, 757, 769, 779, 803, 806, 809, 813, 822, 827, 845,
-	850, 855, 860, 865, 872, 885, 895, 907, 913, 917, 921, 925, 927, 931, 936,
-	955, 968, 985, 1001, 1048, 1056, 1058, 1080, 1085, 1096, 1098, 1102, 1107,
-	1111, 1117, 1121, 1129, 1143, 1149, 1152, 1167, 1172, 1176, 1180, 1188,
-	1195, 1199, 1211, 1216, 1222, 1224, 1231, 1235, 1246, 1249, 1252, 1255,
-	1258, 1261, 1264, 1274, 1288, 1294, 1301, 1310, 1321, 1324, 1328, 1343,
-	1347, 1357, 1362, 1371, 1375, 1379, 1390, 1406, 1411, 1418, 1424, 1441,
-	1451, 1455, 1479,
+	922, 3, 2, 2, 2, 909, 907, 3, 2, 2, 2, 910, 911, 5, 72, 37, 2, 911, 912,
+	5, 250, 126, 2, 912, 922, 3, 2, 2, 2, 913, 918, 5, 250, 126, 2, 914, 915,
+	7, 119, 2, 2, 915, 917, 5, 250, 126, 2, 916, 914, 3, 2, 2, 2, 917, 920,
+	3, 2, 2, 2, 918, 916, 3, 2, 2, 2, 918, 919, 3, 2, 2, 2, 919, 922, 3, 2,
+	2, 2, 920, 918, 3, 2, 2, 2, 921, 902, 3, 2, 2, 2, 921, 910, 3, 2, 2, 2,
+	921, 913, 3, 2, 2, 2, 922, 141, 3, 2, 2, 2, 923, 936, 5, 146, 74, 2, 924,
+	926, 5, 144, 73, 2, 925, 924, 3, 2, 2, 2, 925, 926, 3, 2, 2, 2, 926, 927,
+	3, 2, 2, 2, 927, 929, 7, 118, 2, 2, 928, 930, 5, 162, 82, 2, 929, 928,
+	3, 2, 2, 2, 929, 930, 3, 2, 2, 2, 930, 931, 3, 2, 2, 2, 931, 933, 7, 118,
+	2, 2, 932, 934, 5, 148, 75, 2, 933, 932, 3, 2, 2, 2, 933, 934, 3, 2, 2,
+	2, 934, 936, 3, 2, 2, 2, 935, 923, 3, 2, 2, 2, 935, 925, 3, 2, 2, 2, 936,
+	143, 3, 2, 2, 2, 937, 940, 5, 120, 61, 2, 938, 940, 5, 152, 77, 2, 939,
+	937, 3, 2, 2, 2, 939, 938, 3, 2, 2, 2, 940, 145, 3, 2, 2, 2, 941, 943,
+	5, 16, 9, 2, 942, 941, 3, 2, 2, 2, 943, 946, 3, 2, 2, 2, 944, 942, 3, 2,
+	2, 2, 944, 945, 3, 2, 2, 2, 945, 947, 3, 2, 2, 2, 946, 944, 3, 2, 2, 2,
+	947, 948, 5, 72, 37, 2, 948, 949, 5, 64, 33, 2, 949, 950, 7, 127, 2, 2,
+	950, 951, 5, 162, 82, 2, 951, 147, 3, 2, 2, 2, 952, 953, 5, 152, 77, 2,
+	953, 149, 3, 2, 2, 2, 954, 955, 7, 112, 2, 2, 955, 956, 5, 162, 82, 2,
+	956, 957, 7, 113, 2, 2, 957, 151, 3, 2, 2, 2, 958, 963, 5, 162, 82, 2,
+	959, 960, 7, 119, 2, 2, 960, 962, 5, 162, 82, 2, 961, 959, 3, 2, 2, 2,
+	962, 965, 3, 2, 2, 2, 963, 961, 3, 2, 2, 2, 963, 964, 3, 2, 2, 2, 964,
+	153, 3, 2, 2, 2, 965, 963, 3, 2, 2, 2, 966, 967, 5, 162, 82, 2, 967, 155,
+	3, 2, 2, 2, 968, 969, 5, 162, 82, 2, 969, 157, 3, 2, 2, 2, 970, 971, 9,
+	3, 2, 2, 971, 977, 5, 162, 82, 2, 972, 973, 7, 89, 2, 2, 973, 974, 5, 162,
+	82, 2, 974, 975, 5, 250, 126, 2, 975, 977, 3, 2, 2, 2, 976, 970, 3, 2,
+	2, 2, 976, 972, 3, 2, 2, 2, 977, 159, 3, 2, 2, 2, 978, 979, 5, 158, 80,
+	2, 979, 161, 3, 2, 2, 2, 980, 981, 8, 82, 1, 2, 981, 994, 5, 164, 83, 2,
+	982, 983, 7, 35, 2, 2, 983, 994, 5, 166, 84, 2, 984, 985, 7, 112, 2, 2,
+	985, 986, 5, 72, 37, 2, 986, 987, 7, 113, 2, 2, 987, 988, 5, 162, 82, 19,
+	988, 994, 3, 2, 2, 2, 989, 990, 9, 8, 2, 2, 990, 994, 5, 162, 82, 17, 991,
+	992, 9, 9, 2, 2, 992, 994, 5, 162, 82, 16, 993, 980, 3, 2, 2, 2, 993, 982,
+	3, 2, 2, 2, 993, 984, 3, 2, 2, 2, 993, 989, 3, 2, 2, 2, 993, 991, 3, 2,
+	2, 2, 994, 1066, 3, 2, 2, 2, 995, 996, 12, 15, 2, 2, 996, 997, 9, 10, 2,
+	2, 997, 1065, 5, 162, 82, 16, 998, 999, 12, 14, 2, 2, 999, 1000, 9, 11,
+	2, 2, 1000, 1065, 5, 162, 82, 15, 1001, 1009, 12, 13, 2, 2, 1002, 1003,
+	7, 123, 2, 2, 1003, 1010, 7, 123, 2, 2, 1004, 1005, 7, 122, 2, 2, 1005,
+	1006, 7, 122, 2, 2, 1006, 1010, 7, 122, 2, 2, 1007, 1008, 7, 122, 2, 2,
+	1008, 1010, 7, 122, 2, 2, 1009, 1002, 3, 2, 2, 2, 1009, 1004, 3, 2, 2,
+	2, 1009, 1007, 3, 2, 2, 2, 1010, 1011, 3, 2, 2, 2, 1011, 1065, 5, 162,
+	82, 14, 1012, 1013, 12, 12, 2, 2, 1013, 1014, 9, 12, 2, 2, 1014, 1065,
+	5, 162, 82, 13, 1015, 1016, 12, 10, 2, 2, 1016, 1017, 9, 13, 2, 2, 1017,
+	1065, 5, 162, 82, 11, 1018, 1019, 12, 9, 2, 2, 1019, 1020, 7, 141, 2, 2,
+	1020, 1065, 5, 162, 82, 10, 1021, 1022, 12, 8, 2, 2, 1022, 1023, 7, 143,
+	2, 2, 1023, 1065, 5, 162, 82, 9, 1024, 1025, 12, 7, 2, 2, 1025, 1026, 7,
+	142, 2, 2, 1026, 1065, 5, 162, 82, 8, 1027, 1028, 12, 6, 2, 2, 1028, 1029,
+	7, 133, 2, 2, 1029, 1065, 5, 162, 82, 7, 1030, 1031, 12, 5, 2, 2, 1031,
+	1032, 7, 134, 2, 2, 1032, 1065, 5, 162, 82, 6, 1033, 1034, 12, 4, 2, 2,
+	1034, 1035, 7, 126, 2, 2, 1035, 1036, 5, 162, 82, 2, 1036, 1037, 7, 127,
+	2, 2, 1037, 1038, 5, 162, 82, 5, 1038, 1065, 3, 2, 2, 2, 1039, 1040, 12,
+	3, 2, 2, 1040, 1041, 9, 14, 2, 2, 1041, 1065, 5, 162, 82, 3, 1042, 1043,
+	12, 24, 2, 2, 1043, 1044, 7, 120, 2, 2, 1044, 1065, 5, 250, 126, 2, 1045,
+	1046, 12, 23, 2, 2, 1046, 1047, 7, 120, 2, 2, 1047, 1065, 5, 186, 94, 2,
+	1048, 1049, 12, 22, 2, 2, 1049, 1050, 7, 116, 2, 2, 1050, 1051, 5, 162,
+	82, 2, 1051, 1052, 7, 117, 2, 2, 1052, 1065, 3, 2, 2, 2, 1053, 1054, 12,
+	21, 2, 2, 1054, 1056, 7, 112, 2, 2, 1055, 1057, 5, 152, 77, 2, 1056, 1055,
+	3, 2, 2, 2, 1056, 1057, 3, 2, 2, 2, 1057, 1058, 3, 2, 2, 2, 1058, 1065,
+	7, 113, 2, 2, 1059, 1060, 12, 18, 2, 2, 1060, 1065, 9, 8, 2, 2, 1061, 1062,
+	12, 11, 2, 2, 1062, 1063, 7, 29, 2, 2, 1063, 1065, 5, 72, 37, 2, 1064,
+	995, 3, 2, 2, 2, 1064, 998, 3, 2, 2, 2, 1064, 1001, 3, 2, 2, 2, 1064, 1012,
+	3, 2, 2, 2, 1064, 1015, 3, 2, 2, 2, 1064, 1018, 3, 2, 2, 2, 1064, 1021,
+	3, 2, 2, 2, 1064, 1024, 3, 2, 2, 2, 1064, 1027, 3, 2, 2, 2, 1064, 1030,
+	3, 2, 2, 2, 1064, 1033, 3, 2, 2, 2, 1064, 1039, 3, 2, 2, 2, 1064, 1042,
+	3, 2, 2, 2, 1064, 1045, 3, 2, 2, 2, 1064, 1048, 3, 2, 2, 2, 1064, 1053,
+	3, 2, 2, 2, 1064, 1059, 3, 2, 2, 2, 1064, 1061, 3, 2, 2, 2, 1065, 1068,
+	3, 2, 2, 2, 1066, 1064, 3, 2, 2, 2, 1066, 1067, 3, 2, 2, 2, 1067, 163,
+	3, 2, 2, 2, 1068, 1066, 3, 2, 2, 2, 1069, 1070, 7, 112, 2, 2, 1070, 1071,
+	5, 162, 82, 2, 1071, 1072, 7, 113, 2, 2, 1072, 1094, 3, 2, 2, 2, 1073,
+	1094, 7, 44, 2, 2, 1074, 1094, 7, 42, 2, 2, 1075, 1094, 5, 100, 51, 2,
+	1076, 1094, 5, 250, 126, 2, 1077, 1078, 5, 72, 37, 2, 1078, 1079, 7, 120,
+	2, 2, 1079, 1080, 7, 12, 2, 2, 1080, 1094, 3, 2, 2, 2, 1081, 1082, 7, 49,
+	2, 2, 1082, 1083, 7, 120, 2, 2, 1083, 1094, 7, 12, 2, 2, 1084, 1088, 5,
+	188, 95, 2, 1085, 1089, 5, 196, 99, 2, 1086, 1087, 7, 44, 2, 2, 1087, 1089,
+	5, 198, 100, 2, 1088, 1085, 3, 2, 2, 2, 1088, 1086, 3, 2, 2, 2, 1089, 1094,
+	3, 2, 2, 2, 1090, 1094, 5, 200, 101, 2, 1091, 1094, 5, 244, 123, 2, 1092,
+	1094, 5, 78, 40, 2, 1093, 1069, 3, 2, 2, 2, 1093, 1073, 3, 2, 2, 2, 1093,
+	1074, 3, 2, 2, 2, 1093, 1075, 3, 2, 2, 2, 1093, 1076, 3, 2, 2, 2, 1093,
+	1077, 3, 2, 2, 2, 1093, 1081, 3, 2, 2, 2, 1093, 1084, 3, 2, 2, 2, 1093,
+	1090, 3, 2, 2, 2, 1093, 1091, 3, 2, 2, 2, 1093, 1092, 3, 2, 2, 2, 1094,
+	165, 3, 2, 2, 2, 1095, 1096, 5, 188, 95, 2, 1096, 1097, 5, 168, 85, 2,
+	1097, 1098, 5, 184, 93, 2, 1098, 1107, 3, 2, 2, 2, 1099, 1104, 5, 168,
+	85, 2, 1100, 1105, 5, 172, 87, 2, 1101, 1105, 5, 184, 93, 2, 1102, 1105,
+	5, 174, 88, 2, 1103, 1105, 5, 180, 91, 2, 1104, 1100, 3, 2, 2, 2, 1104,
+	1101, 3, 2, 2, 2, 1104, 1102, 3, 2, 2, 2, 1104, 1103, 3, 2, 2, 2, 1105,
+	1107, 3, 2, 2, 2, 1106, 1095, 3, 2, 2, 2, 1106, 1099, 3, 2, 2, 2, 1107,
+	167, 3, 2, 2, 2, 1108, 1110, 5, 250, 126, 2, 1109, 1111, 5, 190, 96, 2,
+	1110, 1109, 3, 2, 2, 2, 1110, 1111, 3, 2, 2, 2, 1111, 1119, 3, 2, 2, 2,
+	1112, 1113, 7, 120, 2, 2, 1113, 1115, 5, 250, 126, 2, 1114, 1116, 5, 190,
+	96, 2, 1115, 1114, 3, 2, 2, 2, 1115, 1116, 3, 2, 2, 2, 1116, 1118, 3, 2,
+	2, 2, 1117, 1112, 3, 2, 2, 2, 1118, 1121, 3, 2, 2, 2, 1119, 1117, 3, 2,
+	2, 2, 1119, 1120, 3, 2, 2, 2, 1120, 1126, 3, 2, 2, 2, 1121, 1119, 3, 2,
+	2, 2, 1122, 1126, 5, 78, 40, 2, 1123, 1124, 7, 6, 2, 2, 1124, 1126, 5,
+	190, 96, 2, 1125, 1108, 3, 2, 2, 2, 1125, 1122, 3, 2, 2, 2, 1125, 1123,
+	3, 2, 2, 2, 1126, 169, 3, 2, 2, 2, 1127, 1129, 5, 250, 126, 2, 1128, 1130,
+	5, 192, 97, 2, 1129, 1128, 3, 2, 2, 2, 1129, 1130, 3, 2, 2, 2, 1130, 1131,
+	3, 2, 2, 2, 1131, 1132, 5, 184, 93, 2, 1132, 171, 3, 2, 2, 2, 1133, 1137,
+	5, 74, 38, 2, 1134, 1136, 5, 74, 38, 2, 1135, 1134, 3, 2, 2, 2, 1136, 1139,
+	3, 2, 2, 2, 1137, 1135, 3, 2, 2, 2, 1137, 1138, 3, 2, 2, 2, 1138, 1140,
+	3, 2, 2, 2, 1139, 1137, 3, 2, 2, 2, 1140, 1141, 5, 68, 35, 2, 1141, 1161,
+	3, 2, 2, 2, 1142, 1143, 7, 116, 2, 2, 1143, 1144, 5, 162, 82, 2, 1144,
+	1151, 7, 117, 2, 2, 1145, 1146, 7, 116, 2, 2, 1146, 1147, 5, 162, 82, 2,
+	1147, 1148, 7, 117, 2, 2, 1148, 1150, 3, 2, 2, 2, 1149, 1145, 3, 2, 2,
+	2, 1150, 1153, 3, 2, 2, 2, 1151, 1149, 3, 2, 2, 2, 1151, 1152, 3, 2, 2,
+	2, 1152, 1157, 3, 2, 2, 2, 1153, 1151, 3, 2, 2, 2, 1154, 1156, 5, 74, 38,
+	2, 1155, 1154, 3, 2, 2, 2, 1156, 1159, 3, 2, 2, 2, 1157, 1155, 3, 2, 2,
+	2, 1157, 1158, 3, 2, 2, 2, 1158, 1161, 3, 2, 2, 2, 1159, 1157, 3, 2, 2,
+	2, 1160, 1133, 3, 2, 2, 2, 1160, 1142, 3, 2, 2, 2, 1161, 173, 3, 2, 2,
+	2, 1162, 1163, 7, 114, 2, 2, 1163, 1181, 7, 115, 2, 2, 1164, 1165, 7, 114,
+	2, 2, 1165, 1166, 5, 176, 89, 2, 1166, 1167, 7, 156, 2, 2, 1167, 1175,
+	5, 178, 90, 2, 1168, 1169, 7, 119, 2, 2, 1169, 1170, 5, 176, 89, 2, 1170,
+	1171, 7, 156, 2, 2, 1171, 1172, 5, 178, 90, 2, 1172, 1174, 3, 2, 2, 2,
+	1173, 1168, 3, 2, 2, 2, 1174, 1177, 3, 2, 2, 2, 1175, 1173, 3, 2, 2, 2,
+	1175, 1176, 3, 2, 2, 2, 1176, 1178, 3, 2, 2, 2, 1177, 1175, 3, 2, 2, 2,
+	1178, 1179, 7, 115, 2, 2, 1179, 1181, 3, 2, 2, 2, 1180, 1162, 3, 2, 2,
+	2, 1180, 1164, 3, 2, 2, 2, 1181, 175, 3, 2, 2, 2, 1182, 1185, 5, 250, 126,
+	2, 1183, 1185, 5, 162, 82, 2, 1184, 1182, 3, 2, 2, 2, 1184, 1183, 3, 2,
+	2, 2, 1185, 177, 3, 2, 2, 2, 1186, 1189, 5, 100, 51, 2, 1187, 1189, 5,
+	162, 82, 2, 1188, 1186, 3, 2, 2, 2, 1188, 1187, 3, 2, 2, 2, 1189, 179,
+	3, 2, 2, 2, 1190, 1191, 7, 114, 2, 2, 1191, 1196, 5, 182, 92, 2, 1192,
+	1193, 7, 119, 2, 2, 1193, 1195, 5, 182, 92, 2, 1194, 1192, 3, 2, 2, 2,
+	1195, 1198, 3, 2, 2, 2, 1196, 1194, 3, 2, 2, 2, 1196, 1197, 3, 2, 2, 2,
+	1197, 1199, 3, 2, 2, 2, 1198, 1196, 3, 2, 2, 2, 1199, 1200, 7, 115, 2,
+	2, 1200, 181, 3, 2, 2, 2, 1201, 1204, 5, 100, 51, 2, 1202, 1204, 5, 162,
+	82, 2, 1203, 1201, 3, 2, 2, 2, 1203, 1202, 3, 2, 2, 2, 1204, 183, 3, 2,
+	2, 2, 1205, 1207, 5, 198, 100, 2, 1206, 1208, 5, 32, 17, 2, 1207, 1206,
+	3, 2, 2, 2, 1207, 1208, 3, 2, 2, 2, 1208, 185, 3, 2, 2, 2, 1209, 1210,
+	5, 188, 95, 2, 1210, 1211, 5, 196, 99, 2, 1211, 187, 3, 2, 2, 2, 1212,
+	1213, 7, 123, 2, 2, 1213, 1214, 5, 30, 16, 2, 1214, 1215, 7, 122, 2, 2,
+	1215, 189, 3, 2, 2, 2, 1216, 1217, 7, 123, 2, 2, 1217, 1220, 7, 122, 2,
+	2, 1218, 1220, 5, 80, 41, 2, 1219, 1216, 3, 2, 2, 2, 1219, 1218, 3, 2,
+	2, 2, 1220, 191, 3, 2, 2, 2, 1221, 1222, 7, 123, 2, 2, 1222, 1225, 7, 122,
+	2, 2, 1223, 1225, 5, 188, 95, 2, 1224, 1221, 3, 2, 2, 2, 1224, 1223, 3,
+	2, 2, 2, 1225, 193, 3, 2, 2, 2, 1226, 1233, 5, 198, 100, 2, 1227, 1228,
+	7, 120, 2, 2, 1228, 1230, 5, 250, 126, 2, 1229, 1231, 5, 198, 100, 2, 1230,
+	1229, 3, 2, 2, 2, 1230, 1231, 3, 2, 2, 2, 1231, 1233, 3, 2, 2, 2, 1232,
+	1226, 3, 2, 2, 2, 1232, 1227, 3, 2, 2, 2, 1233, 195, 3, 2, 2, 2, 1234,
+	1235, 7, 42, 2, 2, 1235, 1240, 5, 194, 98, 2, 1236, 1237, 5, 250, 126,
+	2, 1237, 1238, 5, 198, 100, 2, 1238, 1240, 3, 2, 2, 2, 1239, 1234, 3, 2,
+	2, 2, 1239, 1236, 3, 2, 2, 2, 1240, 197, 3, 2, 2, 2, 1241, 1243, 7, 112,
+	2, 2, 1242, 1244, 5, 152, 77, 2, 1243, 1242, 3, 2, 2, 2, 1243, 1244, 3,
+	2, 2, 2, 1244, 1245, 3, 2, 2, 2, 1245, 1246, 7, 113, 2, 2, 1246, 199, 3,
+	2, 2, 2, 1247, 1248, 7, 116, 2, 2, 1248, 1249, 5, 202, 102, 2, 1249, 1250,
+	7, 117, 2, 2, 1250, 201, 3, 2, 2, 2, 1251, 1252, 5, 204, 103, 2, 1252,
+	1254, 5, 210, 106, 2, 1253, 1255, 5, 218, 110, 2, 1254, 1253, 3, 2, 2,
+	2, 1254, 1255, 3, 2, 2, 2, 1255, 1257, 3, 2, 2, 2, 1256, 1258, 5, 232,
+	117, 2, 1257, 1256, 3, 2, 2, 2, 1257, 1258, 3, 2, 2, 2, 1258, 1260, 3,
+	2, 2, 2, 1259, 1261, 5, 236, 119, 2, 1260, 1259, 3, 2, 2, 2, 1260, 1261,
+	3, 2, 2, 2, 1261, 1263, 3, 2, 2, 2, 1262, 1264, 5, 226, 114, 2, 1263, 1262,
+	3, 2, 2, 2, 1263, 1264, 3, 2, 2, 2, 1264, 1266, 3, 2, 2, 2, 1265, 1267,
+	5, 224, 113, 2, 1266, 1265, 3, 2, 2, 2, 1266, 1267, 3, 2, 2, 2, 1267, 1269,
+	3, 2, 2, 2, 1268, 1270, 5, 240, 121, 2, 1269, 1268, 3, 2, 2, 2, 1269, 1270,
+	3, 2, 2, 2, 1270, 1272, 3, 2, 2, 2, 1271, 1273, 5, 242, 122, 2, 1272, 1271,
+	3, 2, 2, 2, 1272, 1273, 3, 2, 2, 2, 1273, 203, 3, 2, 2, 2, 1274, 1275,
+	7, 58, 2, 2, 1275, 1276, 5, 206, 104, 2, 1276, 205, 3, 2, 2, 2, 1277, 1282,
+	5, 208, 105, 2, 1278, 1279, 7, 119, 2, 2, 1279, 1281, 5, 208, 105, 2, 1280,
+	1278, 3, 2, 2, 2, 1281, 1284, 3, 2, 2, 2, 1282, 1280, 3, 2, 2, 2, 1282,
+	1283, 3, 2, 2, 2, 1283, 207, 3, 2, 2, 2, 1284, 1282, 3, 2, 2, 2, 1285,
+	1287, 5, 214, 108, 2, 1286, 1288, 5, 250, 126, 2, 1287, 1286, 3, 2, 2,
+	2, 1287, 1288, 3, 2, 2, 2, 1288, 1306, 3, 2, 2, 2, 1289, 1306, 5, 216,
+	109, 2, 1290, 1291, 7, 67, 2, 2, 1291, 1297, 5, 214, 108, 2, 1292, 1293,
+	7, 53, 2, 2, 1293, 1294, 5, 250, 126, 2, 1294, 1295, 7, 87, 2, 2, 1295,
+	1296, 5, 206, 104, 2, 1296, 1298, 3, 2, 2, 2, 1297, 1292, 3, 2, 2, 2, 1298,
+	1299, 3, 2, 2, 2, 1299, 1297, 3, 2, 2, 2, 1299, 1300, 3, 2, 2, 2, 1300,
+	1301, 3, 2, 2, 2, 1301, 1302, 7, 18, 2, 2, 1302, 1303, 5, 206, 104, 2,
+	1303, 1304, 7, 74, 2, 2, 1304, 1306, 3, 2, 2, 2, 1305, 1285, 3, 2, 2, 2,
+	1305, 1289, 3, 2, 2, 2, 1305, 1290, 3, 2, 2, 2, 1306, 209, 3, 2, 2, 2,
+	1307, 1308, 7, 59, 2, 2, 1308, 1312, 5, 250, 126, 2, 1309, 1310, 7, 75,
+	2, 2, 1310, 1311, 7, 83, 2, 2, 1311, 1313, 5, 212, 107, 2, 1312, 1309,
+	3, 2, 2, 2, 1312, 1313, 3, 2, 2, 2, 1313, 211, 3, 2, 2, 2, 1314, 1315,
+	3, 2, 2, 2, 1315, 213, 3, 2, 2, 2, 1316, 1317, 5, 250, 126, 2, 1317, 1318,
+	7, 120, 2, 2, 1318, 1320, 3, 2, 2, 2, 1319, 1316, 3, 2, 2, 2, 1320, 1323,
+	3, 2, 2, 2, 1321, 1319, 3, 2, 2, 2, 1321, 1322, 3, 2, 2, 2, 1322, 1324,
+	3, 2, 2, 2, 1323, 1321, 3, 2, 2, 2, 1324, 1340, 5, 250, 126, 2, 1325, 1326,
+	5, 250, 126, 2, 1326, 1335, 7, 112, 2, 2, 1327, 1332, 5, 214, 108, 2, 1328,
+	1329, 7, 119, 2, 2, 1329, 1331, 5, 214, 108, 2, 1330, 1328, 3, 2, 2, 2,
+	1331, 1334, 3, 2, 2, 2, 1332, 1330, 3, 2, 2, 2, 1332, 1333, 3, 2, 2, 2,
+	1333, 1336, 3, 2, 2, 2, 1334, 1332, 3, 2, 2, 2, 1335, 1327, 3, 2, 2, 2,
+	1335, 1336, 3, 2, 2, 2, 1336, 1337, 3, 2, 2, 2, 1337, 1338, 7, 113, 2,
+	2, 1338, 1340, 3, 2, 2, 2, 1339, 1321, 3, 2, 2, 2, 1339, 1325, 3, 2, 2,
+	2, 1340, 215, 3, 2, 2, 2, 1341, 1342, 7, 112, 2, 2, 1342, 1343, 5, 202,
+	102, 2, 1343, 1344, 7, 113, 2, 2, 1344, 217, 3, 2, 2, 2, 1345, 1346, 7,
+	60, 2, 2, 1346, 1347, 5, 220, 111, 2, 1347, 219, 3, 2, 2, 2, 1348, 1349,
+	8, 111, 1, 2, 1349, 1350, 5, 222, 112, 2, 1350, 1356, 3, 2, 2, 2, 1351,
+	1352, 12, 3, 2, 2, 1352, 1353, 9, 15, 2, 2, 1353, 1355, 5, 220, 111, 4,
+	1354, 1351, 3, 2, 2, 2, 1355, 1358, 3, 2, 2, 2, 1356, 1354, 3, 2, 2, 2,
+	1356, 1357, 3, 2, 2, 2, 1357, 221, 3, 2, 2, 2, 1358, 1356, 3, 2, 2, 2,
+	1359, 1361, 7, 95, 2, 2, 1360, 1359, 3, 2, 2, 2, 1360, 1361, 3, 2, 2, 2,
+	1361, 1362, 3, 2, 2, 2, 1362, 1363, 5, 214, 108, 2, 1363, 1364, 9, 16,
+	2, 2, 1364, 1365, 5, 230, 116, 2, 1365, 1371, 3, 2, 2, 2, 1366, 1367, 7,
+	112, 2, 2, 1367, 1368, 5, 220, 111, 2, 1368, 1369, 7, 113, 2, 2, 1369,
+	1371, 3, 2, 2, 2, 1370, 1360, 3, 2, 2, 2, 1370, 1366, 3, 2, 2, 2, 1371,
+	223, 3, 2, 2, 2, 1372, 1375, 7, 61, 2, 2, 1373, 1376, 7, 107, 2, 2, 1374,
+	1376, 5, 228, 115, 2, 1375, 1373, 3, 2, 2, 2, 1375, 1374, 3, 2, 2, 2, 1376,
+	225, 3, 2, 2, 2, 1377, 1378, 7, 62, 2, 2, 1378, 1379, 7, 63, 2, 2, 1379,
+	1381, 5, 214, 108, 2, 1380, 1382, 9, 17, 2, 2, 1381, 1380, 3, 2, 2, 2,
+	1381, 1382, 3, 2, 2, 2, 1382, 1385, 3, 2, 2, 2, 1383, 1384, 7, 80, 2, 2,
+	1384, 1386, 9, 18, 2, 2, 1385, 1383, 3, 2, 2, 2, 1385, 1386, 3, 2, 2, 2,
+	1386, 1398, 3, 2, 2, 2, 1387, 1388, 7, 119, 2, 2, 1388, 1390, 5, 214, 108,
+	2, 1389, 1391, 9, 17, 2, 2, 1390, 1389, 3, 2, 2, 2, 1390, 1391, 3, 2, 2,
+	2, 1391, 1394, 3, 2, 2, 2, 1392, 1393, 7, 80, 2, 2, 1393, 1395, 9, 18,
+	2, 2, 1394, 1392, 3, 2, 2, 2, 1394, 1395, 3, 2, 2, 2, 1395, 1397, 3, 2,
+	2, 2, 1396, 1387, 3, 2, 2, 2, 1397, 1400, 3, 2, 2, 2, 1398, 1396, 3, 2,
+	2, 2, 1398, 1399, 3, 2, 2, 2, 1399, 227, 3, 2, 2, 2, 1400, 1398, 3, 2,
+	2, 2, 1401, 1402, 7, 127, 2, 2, 1402, 1403, 5, 162, 82, 2, 1403, 229, 3,
+	2, 2, 2, 1404, 1411, 5, 100, 51, 2, 1405, 1411, 5, 228, 115, 2, 1406, 1407,
+	5, 250, 126, 2, 1407, 1408, 7, 127, 2, 2, 1408, 1409, 5, 100, 51, 2, 1409,
+	1411, 3, 2, 2, 2, 1410, 1404, 3, 2, 2, 2, 1410, 1405, 3, 2, 2, 2, 1410,
+	1406, 3, 2, 2, 2, 1411, 231, 3, 2, 2, 2, 1412, 1413, 7, 66, 2, 2, 1413,
+	1414, 7, 76, 2, 2, 1414, 1415, 7, 77, 2, 2, 1415, 1416, 5, 234, 118, 2,
+	1416, 233, 3, 2, 2, 2, 1417, 1418, 3, 2, 2, 2, 1418, 235, 3, 2, 2, 2, 1419,
+	1420, 7, 78, 2, 2, 1420, 1441, 7, 63, 2, 2, 1421, 1422, 9, 19, 2, 2, 1422,
+	1423, 7, 112, 2, 2, 1423, 1428, 5, 214, 108, 2, 1424, 1425, 7, 119, 2,
+	2, 1425, 1427, 5, 214, 108, 2, 1426, 1424, 3, 2, 2, 2, 1427, 1430, 3, 2,
+	2, 2, 1428, 1426, 3, 2, 2, 2, 1428, 1429, 3, 2, 2, 2, 1429, 1431, 3, 2,
+	2, 2, 1430, 1428, 3, 2, 2, 2, 1431, 1432, 7, 113, 2, 2, 1432, 1442, 3,
+	2, 2, 2, 1433, 1438, 5, 214, 108, 2, 1434, 1435, 7, 119, 2, 2, 1435, 1437,
+	5, 214, 108, 2, 1436, 1434, 3, 2, 2, 2, 1437, 1440, 3, 2, 2, 2, 1438, 1436,
+	3, 2, 2, 2, 1438, 1439, 3, 2, 2, 2, 1439, 1442, 3, 2, 2, 2, 1440, 1438,
+	3, 2, 2, 2, 1441, 1421, 3, 2, 2, 2, 1441, 1433, 3, 2, 2, 2, 1442, 1445,
+	3, 2, 2, 2, 1443, 1444, 7, 79, 2, 2, 1444, 1446, 5, 238, 120, 2, 1445,
+	1443, 3, 2, 2, 2, 1445, 1446, 3, 2, 2, 2, 1446, 237, 3, 2, 2, 2, 1447,
+	1448, 5, 220, 111, 2, 1448, 239, 3, 2, 2, 2, 1449, 1452, 7, 72, 2, 2, 1450,
+	1453, 7, 107, 2, 2, 1451, 1453, 5, 228, 115, 2, 1452, 1450, 3, 2, 2, 2,
+	1452, 1451, 3, 2, 2, 2, 1453, 241, 3, 2, 2, 2, 1454, 1455, 7, 24, 2, 2,
+	1455, 1458, 9, 20, 2, 2, 1456, 1457, 7, 90, 2, 2, 1457, 1459, 9, 21, 2,
+	2, 1458, 1456, 3, 2, 2, 2, 1458, 1459, 3, 2, 2, 2, 1459, 243, 3, 2, 2,
+	2, 1460, 1461, 7, 116, 2, 2, 1461, 1462, 5, 246, 124, 2, 1462, 1463, 7,
+	117, 2, 2, 1463, 245, 3, 2, 2, 2, 1464, 1467, 7, 96, 2, 2, 1465, 1468,
+	7, 110, 2, 2, 1466, 1468, 5, 228, 115, 2, 1467, 1465, 3, 2, 2, 2, 1467,
+	1466, 3, 2, 2, 2, 1468, 1472, 3, 2, 2, 2, 1469, 1470, 7, 73, 2, 2, 1470,
+	1471, 9, 22, 2, 2, 1471, 1473, 7, 97, 2, 2, 1472, 1469, 3, 2, 2, 2, 1472,
+	1473, 3, 2, 2, 2, 1473, 1483, 3, 2, 2, 2, 1474, 1475, 7, 98, 2, 2, 1475,
+	1480, 5, 248, 125, 2, 1476, 1477, 7, 119, 2, 2, 1477, 1479, 5, 248, 125,
+	2, 1478, 1476, 3, 2, 2, 2, 1479, 1482, 3, 2, 2, 2, 1480, 1478, 3, 2, 2,
+	2, 1480, 1481, 3, 2, 2, 2, 1481, 1484, 3, 2, 2, 2, 1482, 1480, 3, 2, 2,
+	2, 1483, 1474, 3, 2, 2, 2, 1483, 1484, 3, 2, 2, 2, 1484, 247, 3, 2, 2,
+	2, 1485, 1503, 7, 157, 2, 2, 1486, 1487, 7, 112, 2, 2, 1487, 1492, 5, 214,
+	108, 2, 1488, 1489, 7, 119, 2, 2, 1489, 1491, 5, 214, 108, 2, 1490, 1488,
+	3, 2, 2, 2, 1491, 1494, 3, 2, 2, 2, 1492, 1490, 3, 2, 2, 2, 1492, 1493,
+	3, 2, 2, 2, 1493, 1496, 3, 2, 2, 2, 1494, 1492, 3, 2, 2, 2, 1495, 1497,
+	5, 218, 110, 2, 1496, 1495, 3, 2, 2, 2, 1496, 1497, 3, 2, 2, 2, 1497, 1499,
+	3, 2, 2, 2, 1498, 1500, 5, 224, 113, 2, 1499, 1498, 3, 2, 2, 2, 1499, 1500,
+	3, 2, 2, 2, 1500, 1501, 3, 2, 2, 2, 1501, 1502, 7, 113, 2, 2, 1502, 1504,
+	3, 2, 2, 2, 1503, 1486, 3, 2, 2, 2, 1503, 1504, 3, 2, 2, 2, 1504, 249,
+	3, 2, 2, 2, 1505, 1528, 7, 157, 2, 2, 1506, 1528, 7, 7, 2, 2, 1507, 1528,
+	7, 6, 2, 2, 1508, 1528, 7, 76, 2, 2, 1509, 1528, 7, 78, 2, 2, 1510, 1528,
+	7, 91, 2, 2, 1511, 1528, 7, 88, 2, 2, 1512, 1528, 7, 90, 2, 2, 1513, 1528,
+	7, 92, 2, 2, 1514, 1528, 7, 89, 2, 2, 1515, 1528, 7, 83, 2, 2, 1516, 1528,
+	7, 77, 2, 2, 1517, 1528, 7, 68, 2, 2, 1518, 1528, 7, 72, 2, 2, 1519, 1528,
+	7, 87, 2, 2, 1520, 1528, 7, 96, 2, 2, 1521, 1528, 7, 98, 2, 2, 1522, 1528,
+	7, 99, 2, 2, 1523, 1528, 7, 97, 2, 2, 1524, 1528, 7, 105, 2, 2, 1525, 1528,
+	7, 106, 2, 2, 1526, 1528, 5, 78, 40, 2, 1527, 1505, 3, 2, 2, 2, 1527, 1506,
+	3, 2, 2, 2, 1527, 1507, 3, 2, 2, 2, 1527, 1508, 3, 2, 2, 2, 1527, 1509,
+	3, 2, 2, 2, 1527, 1510, 3, 2, 2, 2, 1527, 1511, 3, 2, 2, 2, 1527, 1512,
+	3, 2, 2, 2, 1527, 1513, 3, 2, 2, 2, 1527, 1514, 3, 2, 2, 2, 1527, 1515,
+	3, 2, 2, 2, 1527, 1516, 3, 2, 2, 2, 1527, 1517, 3, 2, 2, 2, 1527, 1518,
+	3, 2, 2, 2, 1527, 1519, 3, 2, 2, 2, 1527, 1520, 3, 2, 2, 2, 1527, 1521,
+	3, 2, 2, 2, 1527, 1522, 3, 2, 2, 2, 1527, 1523, 3, 2, 2, 2, 1527, 1524,
+	3, 2, 2, 2, 1527, 1525, 3, 2, 2, 2, 1527, 1526, 3, 2, 2, 2, 1528, 251,
+	3, 2, 2, 2, 1529, 1530, 9, 23, 2, 2, 1530, 253, 3, 2, 2, 2, 177, 260, 267,
+	274, 280, 296, 304, 308, 312, 318, 322, 330, 334, 337, 340, 349, 355, 360,
+	363, 369, 381, 388, 397, 404, 410, 414, 423, 426, 430, 438, 443, 447, 453,
+	468, 475, 480, 487, 495, 505, 513, 521, 526, 535, 541, 548, 553, 561, 565,
+	567, 577, 584, 587, 594, 599, 603, 608, 618, 627, 629, 636, 641, 650, 655,
+	658, 663, 672, 688, 698, 701, 709, 719, 727, 730, 733, 741, 749, 757, 769,
+	779, 803, 806, 809, 813, 822, 827, 845, 850, 855, 860, 865, 872, 885, 895,
+	907, 918, 921, 925, 929, 933, 935, 939, 944, 963, 976, 993, 1009, 1056,
+	1064, 1066, 1088, 1093, 1104, 1106, 1110, 1115, 1119, 1125, 1129, 1137,
+	1151, 1157, 1160, 1175, 1180, 1184, 1188, 1196, 1203, 1207, 1219, 1224,
+	1230, 1232, 1239, 1243, 1254, 1257, 1260, 1263, 1266, 1269, 1272, 1282,
+	1287, 1299, 1305, 1312, 1321, 1332, 1335, 1339, 1356, 1360, 1370, 1375,
+	1381, 1385, 1390, 1394, 1398, 1410, 1428, 1438, 1441, 1445, 1452, 1458,
+	1467, 1472, 1480, 1483, 1492, 1496, 1499, 1503, 1527,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
@@ -12105,8 +12133,21 @@ func (s *WhenExpressionContext) ApexType() IApexTypeContext {
 	return t.(IApexTypeContext)
 }
 
-func (s *WhenExpressionContext) ApexIdentifier() IApexIdentifierContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IApexIdentifierContext)(nil)).Elem(), 0)
+func (s *WhenExpressionContext) AllApexIdentifier() []IApexIdentifierContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IApexIdentifierContext)(nil)).Elem())
+	var tst = make([]IApexIdentifierContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IApexIdentifierContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *WhenExpressionContext) ApexIdentifier(i int) IApexIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IApexIdentifierContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -12166,11 +12207,10 @@ func (p *apexParser) WhenExpression() (localctx IWhenExpressionContext) {
 		}
 	}()
 
-	p.SetState(911)
+	p.SetState(919)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case apexParserIntegerLiteral, apexParserFloatingPointLiteral, apexParserBooleanLiteral, apexParserStringLiteral, apexParserNullLiteral:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 94, p.GetParserRuleContext()) {
+	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
 			p.SetState(900)
@@ -12195,7 +12235,7 @@ func (p *apexParser) WhenExpression() (localctx IWhenExpressionContext) {
 			_la = p.GetTokenStream().LA(1)
 		}
 
-	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserSYSTEM, apexParserIdentifier:
+	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
 			p.SetState(908)
@@ -12206,8 +12246,31 @@ func (p *apexParser) WhenExpression() (localctx IWhenExpressionContext) {
 			p.ApexIdentifier()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(911)
+			p.ApexIdentifier()
+		}
+		p.SetState(916)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == apexParserCOMMA {
+			{
+				p.SetState(912)
+				p.Match(apexParserCOMMA)
+			}
+			{
+				p.SetState(913)
+				p.ApexIdentifier()
+			}
+
+			p.SetState(918)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
 	}
 
 	return localctx
@@ -12342,55 +12405,55 @@ func (p *apexParser) ForControl() (localctx IForControlContext) {
 		}
 	}()
 
-	p.SetState(925)
+	p.SetState(933)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 97, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 98, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(913)
+			p.SetState(921)
 			p.EnhancedForControl()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(915)
+		p.SetState(923)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFINAL)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserIntegerLiteral-103))|(1<<(apexParserFloatingPointLiteral-103))|(1<<(apexParserBooleanLiteral-103))|(1<<(apexParserStringLiteral-103))|(1<<(apexParserNullLiteral-103))|(1<<(apexParserLPAREN-103))|(1<<(apexParserLBRACK-103))|(1<<(apexParserLT-103))|(1<<(apexParserBANG-103))|(1<<(apexParserINC-103))|(1<<(apexParserDEC-103)))) != 0) || (((_la-135)&-(0x1f+1)) == 0 && ((1<<uint((_la-135)))&((1<<(apexParserADD-135))|(1<<(apexParserSUB-135))|(1<<(apexParserIdentifier-135))|(1<<(apexParserAT-135)))) != 0) {
 			{
-				p.SetState(914)
+				p.SetState(922)
 				p.ForInit()
 			}
 
 		}
 		{
-			p.SetState(917)
+			p.SetState(925)
 			p.Match(apexParserSEMI)
 		}
-		p.SetState(919)
+		p.SetState(927)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserIntegerLiteral-103))|(1<<(apexParserFloatingPointLiteral-103))|(1<<(apexParserBooleanLiteral-103))|(1<<(apexParserStringLiteral-103))|(1<<(apexParserNullLiteral-103))|(1<<(apexParserLPAREN-103))|(1<<(apexParserLBRACK-103))|(1<<(apexParserLT-103))|(1<<(apexParserBANG-103))|(1<<(apexParserINC-103))|(1<<(apexParserDEC-103)))) != 0) || (((_la-135)&-(0x1f+1)) == 0 && ((1<<uint((_la-135)))&((1<<(apexParserADD-135))|(1<<(apexParserSUB-135))|(1<<(apexParserIdentifier-135)))) != 0) {
 			{
-				p.SetState(918)
+				p.SetState(926)
 				p.expression(0)
 			}
 
 		}
 		{
-			p.SetState(921)
+			p.SetState(929)
 			p.Match(apexParserSEMI)
 		}
-		p.SetState(923)
+		p.SetState(931)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserIntegerLiteral-103))|(1<<(apexParserFloatingPointLiteral-103))|(1<<(apexParserBooleanLiteral-103))|(1<<(apexParserStringLiteral-103))|(1<<(apexParserNullLiteral-103))|(1<<(apexParserLPAREN-103))|(1<<(apexParserLBRACK-103))|(1<<(apexParserLT-103))|(1<<(apexParserBANG-103))|(1<<(apexParserINC-103))|(1<<(apexParserDEC-103)))) != 0) || (((_la-135)&-(0x1f+1)) == 0 && ((1<<uint((_la-135)))&((1<<(apexParserADD-135))|(1<<(apexParserSUB-135))|(1<<(apexParserIdentifier-135)))) != 0) {
 			{
-				p.SetState(922)
+				p.SetState(930)
 				p.ForUpdate()
 			}
 
@@ -12509,20 +12572,20 @@ func (p *apexParser) ForInit() (localctx IForInitContext) {
 		}
 	}()
 
-	p.SetState(929)
+	p.SetState(937)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 98, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 99, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(927)
+			p.SetState(935)
 			p.LocalVariableDeclaration()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(928)
+			p.SetState(936)
 			p.ExpressionList()
 		}
 
@@ -12674,34 +12737,34 @@ func (p *apexParser) EnhancedForControl() (localctx IEnhancedForControlContext)
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(934)
+	p.SetState(942)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserFINAL || _la == apexParserAT {
 		{
-			p.SetState(931)
+			p.SetState(939)
 			p.VariableModifier()
 		}
 
-		p.SetState(936)
+		p.SetState(944)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(937)
+		p.SetState(945)
 		p.ApexType()
 	}
 	{
-		p.SetState(938)
+		p.SetState(946)
 		p.VariableDeclaratorId()
 	}
 	{
-		p.SetState(939)
+		p.SetState(947)
 		p.Match(apexParserCOLON)
 	}
 	{
-		p.SetState(940)
+		p.SetState(948)
 		p.expression(0)
 	}
 
@@ -12808,7 +12871,7 @@ func (p *apexParser) ForUpdate() (localctx IForUpdateContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(942)
+		p.SetState(950)
 		p.ExpressionList()
 	}
 
@@ -12915,15 +12978,15 @@ func (p *apexParser) ParExpression() (localctx IParExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(944)
+		p.SetState(952)
 		p.Match(apexParserLPAREN)
 	}
 	{
-		p.SetState(945)
+		p.SetState(953)
 		p.expression(0)
 	}
 	{
-		p.SetState(946)
+		p.SetState(954)
 		p.Match(apexParserRPAREN)
 	}
 
@@ -13044,24 +13107,24 @@ func (p *apexParser) ExpressionList() (localctx IExpressionListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(948)
+		p.SetState(956)
 		p.expression(0)
 	}
-	p.SetState(953)
+	p.SetState(961)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserCOMMA {
 		{
-			p.SetState(949)
+			p.SetState(957)
 			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(950)
+			p.SetState(958)
 			p.expression(0)
 		}
 
-		p.SetState(955)
+		p.SetState(963)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -13169,7 +13232,7 @@ func (p *apexParser) StatementExpression() (localctx IStatementExpressionContext
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(956)
+		p.SetState(964)
 		p.expression(0)
 	}
 
@@ -13276,7 +13339,7 @@ func (p *apexParser) ConstantExpression() (localctx IConstantExpressionContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(958)
+		p.SetState(966)
 		p.expression(0)
 	}
 
@@ -13423,13 +13486,13 @@ func (p *apexParser) ApexDbExpressionShort() (localctx IApexDbExpressionShortCon
 		}
 	}()
 
-	p.SetState(966)
+	p.SetState(974)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 101, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 102, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(960)
+			p.SetState(968)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -13447,22 +13510,22 @@ func (p *apexParser) ApexDbExpressionShort() (localctx IApexDbExpressionShortCon
 			}
 		}
 		{
-			p.SetState(961)
+			p.SetState(969)
 			p.expression(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(962)
+			p.SetState(970)
 			p.Match(apexParserUPSERT)
 		}
 		{
-			p.SetState(963)
+			p.SetState(971)
 			p.expression(0)
 		}
 		{
-			p.SetState(964)
+			p.SetState(972)
 			p.ApexIdentifier()
 		}
 
@@ -13571,7 +13634,7 @@ func (p *apexParser) ApexDbExpression() (localctx IApexDbExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(968)
+		p.SetState(976)
 		p.ApexDbExpressionShort()
 	}
 
@@ -14466,16 +14529,16 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(983)
+	p.SetState(991)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 102, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 103, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewPrimaryExpressionContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 
 		{
-			p.SetState(971)
+			p.SetState(979)
 			p.Primary()
 		}
 
@@ -14484,11 +14547,11 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(972)
+			p.SetState(980)
 			p.Match(apexParserNEW)
 		}
 		{
-			p.SetState(973)
+			p.SetState(981)
 			p.Creator()
 		}
 
@@ -14497,19 +14560,19 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(974)
+			p.SetState(982)
 			p.Match(apexParserLPAREN)
 		}
 		{
-			p.SetState(975)
+			p.SetState(983)
 			p.ApexType()
 		}
 		{
-			p.SetState(976)
+			p.SetState(984)
 			p.Match(apexParserRPAREN)
 		}
 		{
-			p.SetState(977)
+			p.SetState(985)
 			p.expression(17)
 		}
 
@@ -14518,7 +14581,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(979)
+			p.SetState(987)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -14536,7 +14599,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 			}
 		}
 		{
-			p.SetState(980)
+			p.SetState(988)
 			p.expression(15)
 		}
 
@@ -14545,7 +14608,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(981)
+			p.SetState(989)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -14563,15 +14626,15 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 			}
 		}
 		{
-			p.SetState(982)
+			p.SetState(990)
 			p.expression(14)
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(1056)
+	p.SetState(1064)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 106, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 107, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -14579,19 +14642,19 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(1054)
+			p.SetState(1062)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 105, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 106, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(985)
+				p.SetState(993)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
 				}
 				{
-					p.SetState(986)
+					p.SetState(994)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -14609,20 +14672,20 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(987)
+					p.SetState(995)
 					p.expression(14)
 				}
 
 			case 2:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(988)
+				p.SetState(996)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
 				}
 				{
-					p.SetState(989)
+					p.SetState(997)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -14640,24 +14703,24 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(990)
+					p.SetState(998)
 					p.expression(13)
 				}
 
 			case 3:
 				localctx = NewShiftExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(991)
+				p.SetState(999)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
 				}
-				p.SetState(999)
+				p.SetState(1007)
 				p.GetErrorHandler().Sync(p)
-				switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 103, p.GetParserRuleContext()) {
+				switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 104, p.GetParserRuleContext()) {
 				case 1:
 					{
-						p.SetState(992)
+						p.SetState(1000)
 
 						var _m = p.Match(apexParserLT)
 
@@ -14665,7 +14728,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s121)
 					{
-						p.SetState(993)
+						p.SetState(1001)
 
 						var _m = p.Match(apexParserLT)
 
@@ -14675,7 +14738,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 
 				case 2:
 					{
-						p.SetState(994)
+						p.SetState(1002)
 
 						var _m = p.Match(apexParserGT)
 
@@ -14683,7 +14746,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s120)
 					{
-						p.SetState(995)
+						p.SetState(1003)
 
 						var _m = p.Match(apexParserGT)
 
@@ -14691,7 +14754,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s120)
 					{
-						p.SetState(996)
+						p.SetState(1004)
 
 						var _m = p.Match(apexParserGT)
 
@@ -14701,7 +14764,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 
 				case 3:
 					{
-						p.SetState(997)
+						p.SetState(1005)
 
 						var _m = p.Match(apexParserGT)
 
@@ -14709,7 +14772,7 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 					localctx.(*ShiftExpressionContext).op = append(localctx.(*ShiftExpressionContext).op, localctx.(*ShiftExpressionContext).s120)
 					{
-						p.SetState(998)
+						p.SetState(1006)
 
 						var _m = p.Match(apexParserGT)
 
@@ -14719,20 +14782,20 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 
 				}
 				{
-					p.SetState(1001)
+					p.SetState(1009)
 					p.expression(12)
 				}
 
 			case 4:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1002)
+				p.SetState(1010)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
 				}
 				{
-					p.SetState(1003)
+					p.SetState(1011)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -14750,20 +14813,20 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(1004)
+					p.SetState(1012)
 					p.expression(11)
 				}
 
 			case 5:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1005)
+				p.SetState(1013)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 				}
 				{
-					p.SetState(1006)
+					p.SetState(1014)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -14781,148 +14844,148 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(1007)
+					p.SetState(1015)
 					p.expression(9)
 				}
 
 			case 6:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1008)
+				p.SetState(1016)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 				}
 				{
-					p.SetState(1009)
+					p.SetState(1017)
 
 					var _m = p.Match(apexParserBITAND)
 
 					localctx.(*OpExpressionContext).op = _m
 				}
 				{
-					p.SetState(1010)
+					p.SetState(1018)
 					p.expression(8)
 				}
 
 			case 7:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1011)
+				p.SetState(1019)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
 				{
-					p.SetState(1012)
+					p.SetState(1020)
 
 					var _m = p.Match(apexParserCARET)
 
 					localctx.(*OpExpressionContext).op = _m
 				}
 				{
-					p.SetState(1013)
+					p.SetState(1021)
 					p.expression(7)
 				}
 
 			case 8:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1014)
+				p.SetState(1022)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
 				{
-					p.SetState(1015)
+					p.SetState(1023)
 
 					var _m = p.Match(apexParserBITOR)
 
 					localctx.(*OpExpressionContext).op = _m
 				}
 				{
-					p.SetState(1016)
+					p.SetState(1024)
 					p.expression(6)
 				}
 
 			case 9:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1017)
+				p.SetState(1025)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 				}
 				{
-					p.SetState(1018)
+					p.SetState(1026)
 
 					var _m = p.Match(apexParserAND)
 
 					localctx.(*OpExpressionContext).op = _m
 				}
 				{
-					p.SetState(1019)
+					p.SetState(1027)
 					p.expression(5)
 				}
 
 			case 10:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1020)
+				p.SetState(1028)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 				}
 				{
-					p.SetState(1021)
+					p.SetState(1029)
 
 					var _m = p.Match(apexParserOR)
 
 					localctx.(*OpExpressionContext).op = _m
 				}
 				{
-					p.SetState(1022)
+					p.SetState(1030)
 					p.expression(4)
 				}
 
 			case 11:
 				localctx = NewTernalyExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1023)
+				p.SetState(1031)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				}
 				{
-					p.SetState(1024)
+					p.SetState(1032)
 
 					var _m = p.Match(apexParserQUESTION)
 
 					localctx.(*TernalyExpressionContext).op = _m
 				}
 				{
-					p.SetState(1025)
+					p.SetState(1033)
 					p.expression(0)
 				}
 				{
-					p.SetState(1026)
+					p.SetState(1034)
 					p.Match(apexParserCOLON)
 				}
 				{
-					p.SetState(1027)
+					p.SetState(1035)
 					p.expression(3)
 				}
 
 			case 12:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1029)
+				p.SetState(1037)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 				}
 				{
-					p.SetState(1030)
+					p.SetState(1038)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -14940,103 +15003,103 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(1031)
+					p.SetState(1039)
 					p.expression(1)
 				}
 
 			case 13:
 				localctx = NewFieldAccessContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1032)
+				p.SetState(1040)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 22)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 22)", ""))
 				}
 				{
-					p.SetState(1033)
+					p.SetState(1041)
 					p.Match(apexParserDOT)
 				}
 				{
-					p.SetState(1034)
+					p.SetState(1042)
 					p.ApexIdentifier()
 				}
 
 			case 14:
 				localctx = NewOpExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1035)
+				p.SetState(1043)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 21)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 21)", ""))
 				}
 				{
-					p.SetState(1036)
+					p.SetState(1044)
 					p.Match(apexParserDOT)
 				}
 				{
-					p.SetState(1037)
+					p.SetState(1045)
 					p.ExplicitGenericInvocation()
 				}
 
 			case 15:
 				localctx = NewArrayAccessContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1038)
+				p.SetState(1046)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 20)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 20)", ""))
 				}
 				{
-					p.SetState(1039)
+					p.SetState(1047)
 					p.Match(apexParserLBRACK)
 				}
 				{
-					p.SetState(1040)
+					p.SetState(1048)
 					p.expression(0)
 				}
 				{
-					p.SetState(1041)
+					p.SetState(1049)
 					p.Match(apexParserRBRACK)
 				}
 
 			case 16:
 				localctx = NewMethodInvocationContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1043)
+				p.SetState(1051)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
 				}
 				{
-					p.SetState(1044)
+					p.SetState(1052)
 					p.Match(apexParserLPAREN)
 				}
-				p.SetState(1046)
+				p.SetState(1054)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserIntegerLiteral-103))|(1<<(apexParserFloatingPointLiteral-103))|(1<<(apexParserBooleanLiteral-103))|(1<<(apexParserStringLiteral-103))|(1<<(apexParserNullLiteral-103))|(1<<(apexParserLPAREN-103))|(1<<(apexParserLBRACK-103))|(1<<(apexParserLT-103))|(1<<(apexParserBANG-103))|(1<<(apexParserINC-103))|(1<<(apexParserDEC-103)))) != 0) || (((_la-135)&-(0x1f+1)) == 0 && ((1<<uint((_la-135)))&((1<<(apexParserADD-135))|(1<<(apexParserSUB-135))|(1<<(apexParserIdentifier-135)))) != 0) {
 					{
-						p.SetState(1045)
+						p.SetState(1053)
 						p.ExpressionList()
 					}
 
 				}
 				{
-					p.SetState(1048)
+					p.SetState(1056)
 					p.Match(apexParserRPAREN)
 				}
 
 			case 17:
 				localctx = NewPostUnaryExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1049)
+				p.SetState(1057)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
 				}
 				{
-					p.SetState(1050)
+					p.SetState(1058)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -15057,29 +15120,29 @@ func (p *apexParser) expression(_p int) (localctx IExpressionContext) {
 			case 18:
 				localctx = NewInstanceofExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, apexParserRULE_expression)
-				p.SetState(1051)
+				p.SetState(1059)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
 				}
 				{
-					p.SetState(1052)
+					p.SetState(1060)
 
 					var _m = p.Match(apexParserINSTANCEOF)
 
 					localctx.(*InstanceofExpressionContext).op = _m
 				}
 				{
-					p.SetState(1053)
+					p.SetState(1061)
 					p.ApexType()
 				}
 
 			}
 
 		}
-		p.SetState(1058)
+		p.SetState(1066)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 106, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 107, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -15289,105 +15352,105 @@ func (p *apexParser) Primary() (localctx IPrimaryContext) {
 		}
 	}()
 
-	p.SetState(1083)
+	p.SetState(1091)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 108, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 109, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1059)
+			p.SetState(1067)
 			p.Match(apexParserLPAREN)
 		}
 		{
-			p.SetState(1060)
+			p.SetState(1068)
 			p.expression(0)
 		}
 		{
-			p.SetState(1061)
+			p.SetState(1069)
 			p.Match(apexParserRPAREN)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1063)
+			p.SetState(1071)
 			p.Match(apexParserTHIS)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1064)
+			p.SetState(1072)
 			p.Match(apexParserSUPER)
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1065)
+			p.SetState(1073)
 			p.Literal()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1066)
+			p.SetState(1074)
 			p.ApexIdentifier()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1067)
+			p.SetState(1075)
 			p.ApexType()
 		}
 		{
-			p.SetState(1068)
+			p.SetState(1076)
 			p.Match(apexParserDOT)
 		}
 		{
-			p.SetState(1069)
+			p.SetState(1077)
 			p.Match(apexParserCLASS)
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(1071)
+			p.SetState(1079)
 			p.Match(apexParserVOID)
 		}
 		{
-			p.SetState(1072)
+			p.SetState(1080)
 			p.Match(apexParserDOT)
 		}
 		{
-			p.SetState(1073)
+			p.SetState(1081)
 			p.Match(apexParserCLASS)
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(1074)
+			p.SetState(1082)
 			p.NonWildcardTypeArguments()
 		}
-		p.SetState(1078)
+		p.SetState(1086)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserSUPER, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
 			{
-				p.SetState(1075)
+				p.SetState(1083)
 				p.ExplicitGenericInvocationSuffix()
 			}
 
 		case apexParserTHIS:
 			{
-				p.SetState(1076)
+				p.SetState(1084)
 				p.Match(apexParserTHIS)
 			}
 			{
-				p.SetState(1077)
+				p.SetState(1085)
 				p.Arguments()
 			}
 
@@ -15398,21 +15461,21 @@ func (p *apexParser) Primary() (localctx IPrimaryContext) {
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(1080)
+			p.SetState(1088)
 			p.SoqlLiteral()
 		}
 
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(1081)
+			p.SetState(1089)
 			p.SoslLiteral()
 		}
 
 	case 11:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(1082)
+			p.SetState(1090)
 			p.PrimitiveType()
 		}
 
@@ -15569,55 +15632,55 @@ func (p *apexParser) Creator() (localctx ICreatorContext) {
 		}
 	}()
 
-	p.SetState(1096)
+	p.SetState(1104)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserLT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1085)
+			p.SetState(1093)
 			p.NonWildcardTypeArguments()
 		}
 		{
-			p.SetState(1086)
+			p.SetState(1094)
 			p.CreatedName()
 		}
 		{
-			p.SetState(1087)
+			p.SetState(1095)
 			p.ClassCreatorRest()
 		}
 
 	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1089)
+			p.SetState(1097)
 			p.CreatedName()
 		}
-		p.SetState(1094)
+		p.SetState(1102)
 		p.GetErrorHandler().Sync(p)
-		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 109, p.GetParserRuleContext()) {
+		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 110, p.GetParserRuleContext()) {
 		case 1:
 			{
-				p.SetState(1090)
+				p.SetState(1098)
 				p.ArrayCreatorRest()
 			}
 
 		case 2:
 			{
-				p.SetState(1091)
+				p.SetState(1099)
 				p.ClassCreatorRest()
 			}
 
 		case 3:
 			{
-				p.SetState(1092)
+				p.SetState(1100)
 				p.MapCreatorRest()
 			}
 
 		case 4:
 			{
-				p.SetState(1093)
+				p.SetState(1101)
 				p.SetCreatorRest()
 			}
 
@@ -15779,52 +15842,52 @@ func (p *apexParser) CreatedName() (localctx ICreatedNameContext) {
 		}
 	}()
 
-	p.SetState(1115)
+	p.SetState(1123)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 114, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 115, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1098)
+			p.SetState(1106)
 			p.ApexIdentifier()
 		}
-		p.SetState(1100)
+		p.SetState(1108)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == apexParserLT {
 			{
-				p.SetState(1099)
+				p.SetState(1107)
 				p.TypeArgumentsOrDiamond()
 			}
 
 		}
-		p.SetState(1109)
+		p.SetState(1117)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == apexParserDOT {
 			{
-				p.SetState(1102)
+				p.SetState(1110)
 				p.Match(apexParserDOT)
 			}
 			{
-				p.SetState(1103)
+				p.SetState(1111)
 				p.ApexIdentifier()
 			}
-			p.SetState(1105)
+			p.SetState(1113)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == apexParserLT {
 				{
-					p.SetState(1104)
+					p.SetState(1112)
 					p.TypeArgumentsOrDiamond()
 				}
 
 			}
 
-			p.SetState(1111)
+			p.SetState(1119)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
@@ -15832,18 +15895,18 @@ func (p *apexParser) CreatedName() (localctx ICreatedNameContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1112)
+			p.SetState(1120)
 			p.PrimitiveType()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1113)
+			p.SetState(1121)
 			p.Match(apexParserSET)
 		}
 		{
-			p.SetState(1114)
+			p.SetState(1122)
 			p.TypeArgumentsOrDiamond()
 		}
 
@@ -15973,22 +16036,22 @@ func (p *apexParser) InnerCreator() (localctx IInnerCreatorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1117)
+		p.SetState(1125)
 		p.ApexIdentifier()
 	}
-	p.SetState(1119)
+	p.SetState(1127)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserLT {
 		{
-			p.SetState(1118)
+			p.SetState(1126)
 			p.NonWildcardTypeArgumentsOrDiamond()
 		}
 
 	}
 	{
-		p.SetState(1121)
+		p.SetState(1129)
 		p.ClassCreatorRest()
 	}
 
@@ -16142,87 +16205,87 @@ func (p *apexParser) ArrayCreatorRest() (localctx IArrayCreatorRestContext) {
 
 	var _alt int
 
-	p.SetState(1150)
+	p.SetState(1158)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 119, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 120, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1123)
+			p.SetState(1131)
 			p.TypedArray()
 		}
-		p.SetState(1127)
+		p.SetState(1135)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == apexParserLBRACK {
 			{
-				p.SetState(1124)
+				p.SetState(1132)
 				p.TypedArray()
 			}
 
-			p.SetState(1129)
+			p.SetState(1137)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(1130)
+			p.SetState(1138)
 			p.ArrayInitializer()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1132)
+			p.SetState(1140)
 			p.Match(apexParserLBRACK)
 		}
 		{
-			p.SetState(1133)
+			p.SetState(1141)
 			p.expression(0)
 		}
 		{
-			p.SetState(1134)
+			p.SetState(1142)
 			p.Match(apexParserRBRACK)
 		}
-		p.SetState(1141)
+		p.SetState(1149)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 117, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 118, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(1135)
+					p.SetState(1143)
 					p.Match(apexParserLBRACK)
 				}
 				{
-					p.SetState(1136)
+					p.SetState(1144)
 					p.expression(0)
 				}
 				{
-					p.SetState(1137)
+					p.SetState(1145)
 					p.Match(apexParserRBRACK)
 				}
 
 			}
-			p.SetState(1143)
+			p.SetState(1151)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 117, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 118, p.GetParserRuleContext())
 		}
-		p.SetState(1147)
+		p.SetState(1155)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 118, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 119, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(1144)
+					p.SetState(1152)
 					p.TypedArray()
 				}
 
 			}
-			p.SetState(1149)
+			p.SetState(1157)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 118, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 119, p.GetParserRuleContext())
 		}
 
 	}
@@ -16365,66 +16428,66 @@ func (p *apexParser) MapCreatorRest() (localctx IMapCreatorRestContext) {
 		}
 	}()
 
-	p.SetState(1170)
+	p.SetState(1178)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 121, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 122, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1152)
+			p.SetState(1160)
 			p.Match(apexParserLBRACE)
 		}
 		{
-			p.SetState(1153)
+			p.SetState(1161)
 			p.Match(apexParserRBRACE)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1154)
+			p.SetState(1162)
 			p.Match(apexParserLBRACE)
 		}
 		{
-			p.SetState(1155)
+			p.SetState(1163)
 			p.MapKey()
 		}
 		{
-			p.SetState(1156)
+			p.SetState(1164)
 			p.Match(apexParserLAMBDA_LIKE)
 		}
 		{
-			p.SetState(1157)
+			p.SetState(1165)
 			p.MapValue()
 		}
-		p.SetState(1165)
+		p.SetState(1173)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == apexParserCOMMA {
 			{
-				p.SetState(1158)
+				p.SetState(1166)
 				p.Match(apexParserCOMMA)
 			}
 			{
-				p.SetState(1159)
+				p.SetState(1167)
 				p.MapKey()
 			}
 			{
-				p.SetState(1160)
+				p.SetState(1168)
 				p.Match(apexParserLAMBDA_LIKE)
 			}
 			{
-				p.SetState(1161)
+				p.SetState(1169)
 				p.MapValue()
 			}
 
-			p.SetState(1167)
+			p.SetState(1175)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(1168)
+			p.SetState(1176)
 			p.Match(apexParserRBRACE)
 		}
 
@@ -16541,20 +16604,20 @@ func (p *apexParser) MapKey() (localctx IMapKeyContext) {
 		}
 	}()
 
-	p.SetState(1174)
+	p.SetState(1182)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 122, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 123, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1172)
+			p.SetState(1180)
 			p.ApexIdentifier()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1173)
+			p.SetState(1181)
 			p.expression(0)
 		}
 
@@ -16671,20 +16734,20 @@ func (p *apexParser) MapValue() (localctx IMapValueContext) {
 		}
 	}()
 
-	p.SetState(1178)
+	p.SetState(1186)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 123, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 124, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1176)
+			p.SetState(1184)
 			p.Literal()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1177)
+			p.SetState(1185)
 			p.expression(0)
 		}
 
@@ -16807,33 +16870,33 @@ func (p *apexParser) SetCreatorRest() (localctx ISetCreatorRestContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1180)
+		p.SetState(1188)
 		p.Match(apexParserLBRACE)
 	}
 	{
-		p.SetState(1181)
+		p.SetState(1189)
 		p.SetValue()
 	}
-	p.SetState(1186)
+	p.SetState(1194)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserCOMMA {
 		{
-			p.SetState(1182)
+			p.SetState(1190)
 			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(1183)
+			p.SetState(1191)
 			p.SetValue()
 		}
 
-		p.SetState(1188)
+		p.SetState(1196)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(1189)
+		p.SetState(1197)
 		p.Match(apexParserRBRACE)
 	}
 
@@ -16948,20 +17011,20 @@ func (p *apexParser) SetValue() (localctx ISetValueContext) {
 		}
 	}()
 
-	p.SetState(1193)
+	p.SetState(1201)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 125, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 126, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1191)
+			p.SetState(1199)
 			p.Literal()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1192)
+			p.SetState(1200)
 			p.expression(0)
 		}
 
@@ -17080,15 +17143,15 @@ func (p *apexParser) ClassCreatorRest() (localctx IClassCreatorRestContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1195)
+		p.SetState(1203)
 		p.Arguments()
 	}
-	p.SetState(1197)
+	p.SetState(1205)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 126, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 127, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(1196)
+			p.SetState(1204)
 			p.ClassBody()
 		}
 
@@ -17207,11 +17270,11 @@ func (p *apexParser) ExplicitGenericInvocation() (localctx IExplicitGenericInvoc
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1199)
+		p.SetState(1207)
 		p.NonWildcardTypeArguments()
 	}
 	{
-		p.SetState(1200)
+		p.SetState(1208)
 		p.ExplicitGenericInvocationSuffix()
 	}
 
@@ -17318,15 +17381,15 @@ func (p *apexParser) NonWildcardTypeArguments() (localctx INonWildcardTypeArgume
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1202)
+		p.SetState(1210)
 		p.Match(apexParserLT)
 	}
 	{
-		p.SetState(1203)
+		p.SetState(1211)
 		p.TypeList()
 	}
 	{
-		p.SetState(1204)
+		p.SetState(1212)
 		p.Match(apexParserGT)
 	}
 
@@ -17431,24 +17494,24 @@ func (p *apexParser) TypeArgumentsOrDiamond() (localctx ITypeArgumentsOrDiamondC
 		}
 	}()
 
-	p.SetState(1209)
+	p.SetState(1217)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 127, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 128, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1206)
+			p.SetState(1214)
 			p.Match(apexParserLT)
 		}
 		{
-			p.SetState(1207)
+			p.SetState(1215)
 			p.Match(apexParserGT)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1208)
+			p.SetState(1216)
 			p.TypeArguments()
 		}
 
@@ -17555,24 +17618,24 @@ func (p *apexParser) NonWildcardTypeArgumentsOrDiamond() (localctx INonWildcardT
 		}
 	}()
 
-	p.SetState(1214)
+	p.SetState(1222)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 128, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 129, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1211)
+			p.SetState(1219)
 			p.Match(apexParserLT)
 		}
 		{
-			p.SetState(1212)
+			p.SetState(1220)
 			p.Match(apexParserGT)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1213)
+			p.SetState(1221)
 			p.NonWildcardTypeArguments()
 		}
 
@@ -17689,33 +17752,33 @@ func (p *apexParser) SuperSuffix() (localctx ISuperSuffixContext) {
 		}
 	}()
 
-	p.SetState(1222)
+	p.SetState(1230)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserLPAREN:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1216)
+			p.SetState(1224)
 			p.Arguments()
 		}
 
 	case apexParserDOT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1217)
+			p.SetState(1225)
 			p.Match(apexParserDOT)
 		}
 		{
-			p.SetState(1218)
+			p.SetState(1226)
 			p.ApexIdentifier()
 		}
-		p.SetState(1220)
+		p.SetState(1228)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 129, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 130, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(1219)
+				p.SetState(1227)
 				p.Arguments()
 			}
 
@@ -17850,29 +17913,29 @@ func (p *apexParser) ExplicitGenericInvocationSuffix() (localctx IExplicitGeneri
 		}
 	}()
 
-	p.SetState(1229)
+	p.SetState(1237)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserSUPER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1224)
+			p.SetState(1232)
 			p.Match(apexParserSUPER)
 		}
 		{
-			p.SetState(1225)
+			p.SetState(1233)
 			p.SuperSuffix()
 		}
 
 	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1226)
+			p.SetState(1234)
 			p.ApexIdentifier()
 		}
 		{
-			p.SetState(1227)
+			p.SetState(1235)
 			p.Arguments()
 		}
 
@@ -17984,22 +18047,22 @@ func (p *apexParser) Arguments() (localctx IArgumentsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1231)
+		p.SetState(1239)
 		p.Match(apexParserLPAREN)
 	}
-	p.SetState(1233)
+	p.SetState(1241)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(apexParserNEW-33))|(1<<(apexParserSUPER-33))|(1<<(apexParserTHIS-33))|(1<<(apexParserVOID-33)))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || (((_la-103)&-(0x1f+1)) == 0 && ((1<<uint((_la-103)))&((1<<(apexParserRUNAS-103))|(1<<(apexParserSYSTEM-103))|(1<<(apexParserIntegerLiteral-103))|(1<<(apexParserFloatingPointLiteral-103))|(1<<(apexParserBooleanLiteral-103))|(1<<(apexParserStringLiteral-103))|(1<<(apexParserNullLiteral-103))|(1<<(apexParserLPAREN-103))|(1<<(apexParserLBRACK-103))|(1<<(apexParserLT-103))|(1<<(apexParserBANG-103))|(1<<(apexParserINC-103))|(1<<(apexParserDEC-103)))) != 0) || (((_la-135)&-(0x1f+1)) == 0 && ((1<<uint((_la-135)))&((1<<(apexParserADD-135))|(1<<(apexParserSUB-135))|(1<<(apexParserIdentifier-135)))) != 0) {
 		{
-			p.SetState(1232)
+			p.SetState(1240)
 			p.ExpressionList()
 		}
 
 	}
 	{
-		p.SetState(1235)
+		p.SetState(1243)
 		p.Match(apexParserRPAREN)
 	}
 
@@ -18106,15 +18169,15 @@ func (p *apexParser) SoqlLiteral() (localctx ISoqlLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1237)
+		p.SetState(1245)
 		p.Match(apexParserLBRACK)
 	}
 	{
-		p.SetState(1238)
+		p.SetState(1246)
 		p.Query()
 	}
 	{
-		p.SetState(1239)
+		p.SetState(1247)
 		p.Match(apexParserRBRACK)
 	}
 
@@ -18302,86 +18365,86 @@ func (p *apexParser) Query() (localctx IQueryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1241)
+		p.SetState(1249)
 		p.SelectClause()
 	}
 	{
-		p.SetState(1242)
+		p.SetState(1250)
 		p.FromClause()
 	}
-	p.SetState(1244)
+	p.SetState(1252)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserWHERE {
 		{
-			p.SetState(1243)
+			p.SetState(1251)
 			p.WhereClause()
 		}
 
 	}
-	p.SetState(1247)
+	p.SetState(1255)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserWITH {
 		{
-			p.SetState(1246)
+			p.SetState(1254)
 			p.WithClause()
 		}
 
 	}
-	p.SetState(1250)
+	p.SetState(1258)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserGROUP {
 		{
-			p.SetState(1249)
+			p.SetState(1257)
 			p.GroupClause()
 		}
 
 	}
-	p.SetState(1253)
+	p.SetState(1261)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserORDER {
 		{
-			p.SetState(1252)
+			p.SetState(1260)
 			p.OrderClause()
 		}
 
 	}
-	p.SetState(1256)
+	p.SetState(1264)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserLIMIT {
 		{
-			p.SetState(1255)
+			p.SetState(1263)
 			p.LimitClause()
 		}
 
 	}
-	p.SetState(1259)
+	p.SetState(1267)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserOFFSET {
 		{
-			p.SetState(1258)
+			p.SetState(1266)
 			p.OffsetClause()
 		}
 
 	}
-	p.SetState(1262)
+	p.SetState(1270)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserFOR {
 		{
-			p.SetState(1261)
+			p.SetState(1269)
 			p.ViewClause()
 		}
 
@@ -18494,11 +18557,11 @@ func (p *apexParser) SelectClause() (localctx ISelectClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1264)
+		p.SetState(1272)
 		p.Match(apexParserSELECT)
 	}
 	{
-		p.SetState(1265)
+		p.SetState(1273)
 		p.FieldList()
 	}
 
@@ -18627,24 +18690,24 @@ func (p *apexParser) FieldList() (localctx IFieldListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1267)
+		p.SetState(1275)
 		p.SelectField()
 	}
-	p.SetState(1272)
+	p.SetState(1280)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == apexParserCOMMA {
 		{
-			p.SetState(1268)
+			p.SetState(1276)
 			p.Match(apexParserCOMMA)
 		}
 		{
-			p.SetState(1269)
+			p.SetState(1277)
 			p.SelectField()
 		}
 
-		p.SetState(1274)
+		p.SetState(1282)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -18700,6 +18763,29 @@ func (s *SelectFieldContext) SoqlField() ISoqlFieldContext {
 	return t.(ISoqlFieldContext)
 }
 
+func (s *SelectFieldContext) AllApexIdentifier() []IApexIdentifierContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IApexIdentifierContext)(nil)).Elem())
+	var tst = make([]IApexIdentifierContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IApexIdentifierContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SelectFieldContext) ApexIdentifier(i int) IApexIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IApexIdentifierContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IApexIdentifierContext)
+}
+
 func (s *SelectFieldContext) Subquery() ISubqueryContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISubqueryContext)(nil)).Elem(), 0)
 
@@ -18753,29 +18839,6 @@ func (s *SelectFieldContext) WHEN(i int) antlr.TerminalNode {
 	return s.GetToken(apexParserWHEN, i)
 }
 
-func (s *SelectFieldContext) AllApexIdentifier() []IApexIdentifierContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IApexIdentifierContext)(nil)).Elem())
-	var tst = make([]IApexIdentifierContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IApexIdentifierContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *SelectFieldContext) ApexIdentifier(i int) IApexIdentifierContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IApexIdentifierContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IApexIdentifierContext)
-}
-
 func (s *SelectFieldContext) AllTHEN() []antlr.TerminalNode {
 	return s.GetTokens(apexParserTHEN)
 }
@@ -18835,70 +18898,81 @@ func (p *apexParser) SelectField() (localctx ISelectFieldContext) {
 		}
 	}()
 
-	p.SetState(1292)
+	p.SetState(1303)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1275)
+			p.SetState(1283)
 			p.SoqlField()
 		}
+		p.SetState(1285)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || _la == apexParserRUNAS || _la == apexParserSYSTEM || _la == apexParserIdentifier {
+			{
+				p.SetState(1284)
+				p.ApexIdentifier()
+			}
+
+		}
 
-	case apexParserSELECT:
+	case apexParserLPAREN:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1276)
+			p.SetState(1287)
 			p.Subquery()
 		}
 
 	case apexParserTYPEOF:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1277)
+			p.SetState(1288)
 			p.Match(apexParserTYPEOF)
 		}
 		{
-			p.SetState(1278)
+			p.SetState(1289)
 			p.SoqlField()
 		}
-		p.SetState(1284)
+		p.SetState(1295)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for ok := true; ok; ok = _la == apexParserWHEN {
 			{
-				p.SetState(1279)
+				p.SetState(1290)
 				p.Match(apexParserWHEN)
 			}
 			{
-				p.SetState(1280)
+				p.SetState(1291)
 				p.ApexIdentifier()
 			}
 			{
-				p.SetState(1281)
+				p.SetState(1292)
 				p.Match(apexParserTHEN)
 			}
 			{
-				p.SetState(1282)
+				p.SetState(1293)
 				p.FieldList()
 			}
 
-			p.SetState(1286)
+			p.SetState(1297)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(1288)
+			p.SetState(1299)
 			p.Match(apexParserELSE)
 		}
 		{
-			p.SetState(1289)
+			p.SetState(1300)
 			p.FieldList()
 		}
 		{
-			p.SetState(1290)
+			p.SetState(1301)
 			p.Match(apexParserEND)
 		}
 
@@ -19032,28 +19106,28 @@ func (p *apexParser) FromClause() (localctx IFromClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1294)
+		p.SetState(1305)
 		p.Match(apexParserFROM)
 	}
 	{
-		p.SetState(1295)
+		p.SetState(1306)
 		p.ApexIdentifier()
 	}
-	p.SetState(1299)
+	p.SetState(1310)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserUSING {
 		{
-			p.SetState(1296)
+			p.SetState(1307)
 			p.Match(apexParserUSING)
 		}
 		{
-			p.SetState(1297)
+			p.SetState(1308)
 			p.Match(apexParserSCOPE)
 		}
 		{
-			p.SetState(1298)
+			p.SetState(1309)
 			p.FilterScope()
 		}
 
@@ -19387,34 +19461,34 @@ func (p *apexParser) SoqlField() (localctx ISoqlFieldContext) {
 
 	var _alt int
 
-	p.SetState(1326)
+	p.SetState(1337)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 147, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 149, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewSoqlFieldReferenceContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(1308)
+		p.SetState(1319)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 144, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 146, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(1303)
+					p.SetState(1314)
 					p.ApexIdentifier()
 				}
 				{
-					p.SetState(1304)
+					p.SetState(1315)
 					p.Match(apexParserDOT)
 				}
 
 			}
-			p.SetState(1310)
+			p.SetState(1321)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 144, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 146, p.GetParserRuleContext())
 		}
 		{
-			p.SetState(1311)
+			p.SetState(1322)
 			p.ApexIdentifier()
 		}
 
@@ -19422,44 +19496,44 @@ func (p *apexParser) SoqlField() (localctx ISoqlFieldContext) {
 		localctx = NewSoqlFunctionCallContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1312)
+			p.SetState(1323)
 			p.ApexIdentifier()
 		}
 		{
-			p.SetState(1313)
+			p.SetState(1324)
 			p.Match(apexParserLPAREN)
 		}
-		p.SetState(1322)
+		p.SetState(1333)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<apexParserSET)|(1<<apexParserGET)|(1<<apexParserBOOLEAN)|(1<<apexParserDOUBLE)|(1<<apexParserFLOAT)|(1<<apexParserINTEGER)|(1<<apexParserSTRING)|(1<<apexParserLONG))) != 0) || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserINSERT-66))|(1<<(apexParserUPSERT-66))|(1<<(apexParserUPDATE-66))|(1<<(apexParserDELETE-66))|(1<<(apexParserUNDELETE-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || _la == apexParserRUNAS || _la == apexParserSYSTEM || _la == apexParserIdentifier {
 			{
-				p.SetState(1314)
+				p.SetState(1325)
 				p.SoqlField()
 			}
-			p.SetState(1319)
+			p.SetState(1330)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			for _la == apexParserCOMMA {
 				{
-					p.SetState(1315)
+					p.SetState(1326)
 					p.Match(apexParserCOMMA)
 				}
 				{
-					p.SetState(1316)
+					p.SetState(1327)
 					p.SoqlField()
 				}
 
-				p.SetState(1321)
+				p.SetState(1332)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
 
 		}
 		{
-			p.SetState(1324)
+			p.SetState(1335)
 			p.Match(apexParserRPAREN)
 		}
 
@@ -19506,6 +19580,10 @@ func NewSubqueryContext(parser antlr.Parser, parent antlr.ParserRuleContext, inv
 
 func (s *SubqueryContext) GetParser() antlr.Parser { return s.parser }
 
+func (s *SubqueryContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(apexParserLPAREN, 0)
+}
+
 func (s *SubqueryContext) Query() IQueryContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IQueryContext)(nil)).Elem(), 0)
 
@@ -19516,6 +19594,10 @@ func (s *SubqueryContext) Query() IQueryContext {
 	return t.(IQueryContext)
 }
 
+func (s *SubqueryContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(apexParserRPAREN, 0)
+}
+
 func (s *SubqueryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -19568,9 +19650,17 @@ func (p *apexParser) Subquery() (localctx ISubqueryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1328)
+		p.SetState(1339)
+		p.Match(apexParserLPAREN)
+	}
+	{
+		p.SetState(1340)
 		p.Query()
 	}
+	{
+		p.SetState(1341)
+		p.Match(apexParserRPAREN)
+	}
 
 	return localctx
 }
@@ -19679,11 +19769,11 @@ func (p *apexParser) WhereClause() (localctx IWhereClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1330)
+		p.SetState(1343)
 		p.Match(apexParserWHERE)
 	}
 	{
-		p.SetState(1331)
+		p.SetState(1344)
 		p.whereFields(0)
 	}
 
@@ -19844,14 +19934,14 @@ func (p *apexParser) whereFields(_p int) (localctx IWhereFieldsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1334)
+		p.SetState(1347)
 		p.WhereField()
 	}
 
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(1341)
+	p.SetState(1354)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 148, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 150, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -19861,13 +19951,13 @@ func (p *apexParser) whereFields(_p int) (localctx IWhereFieldsContext) {
 			_prevctx = localctx
 			localctx = NewWhereFieldsContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, apexParserRULE_whereFields)
-			p.SetState(1336)
+			p.SetState(1349)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 			}
 			{
-				p.SetState(1337)
+				p.SetState(1350)
 
 				var _lt = p.GetTokenStream().LT(1)
 
@@ -19885,14 +19975,14 @@ func (p *apexParser) whereFields(_p int) (localctx IWhereFieldsContext) {
 				}
 			}
 			{
-				p.SetState(1338)
+				p.SetState(1351)
 				p.whereFields(2)
 			}
 
 		}
-		p.SetState(1343)
+		p.SetState(1356)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 148, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 150, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -20040,29 +20130,29 @@ func (p *apexParser) WhereField() (localctx IWhereFieldContext) {
 		}
 	}()
 
-	p.SetState(1355)
+	p.SetState(1368)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserSOQL_NOT, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(1345)
+		p.SetState(1358)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == apexParserSOQL_NOT {
 			{
-				p.SetState(1344)
+				p.SetState(1357)
 				p.Match(apexParserSOQL_NOT)
 			}
 
 		}
 		{
-			p.SetState(1347)
+			p.SetState(1360)
 			p.SoqlField()
 		}
 		{
-			p.SetState(1348)
+			p.SetState(1361)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -20080,22 +20170,22 @@ func (p *apexParser) WhereField() (localctx IWhereFieldContext) {
 			}
 		}
 		{
-			p.SetState(1349)
+			p.SetState(1362)
 			p.SoqlValue()
 		}
 
 	case apexParserLPAREN:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1351)
+			p.SetState(1364)
 			p.Match(apexParserLPAREN)
 		}
 		{
-			p.SetState(1352)
+			p.SetState(1365)
 			p.whereFields(0)
 		}
 		{
-			p.SetState(1353)
+			p.SetState(1366)
 			p.Match(apexParserRPAREN)
 		}
 
@@ -20214,22 +20304,22 @@ func (p *apexParser) LimitClause() (localctx ILimitClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1357)
+		p.SetState(1370)
 		p.Match(apexParserLIMIT)
 	}
-	p.SetState(1360)
+	p.SetState(1373)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserIntegerLiteral:
 		{
-			p.SetState(1358)
+			p.SetState(1371)
 			p.Match(apexParserIntegerLiteral)
 		}
 
 	case apexParserCOLON:
 		{
-			p.SetState(1359)
+			p.SetState(1372)
 			p.BindVariable()
 		}
 
@@ -20247,27 +20337,13 @@ type IOrderClauseContext interface {
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetAsc_desc returns the asc_desc token.
-	GetAsc_desc() antlr.Token
-
-	// GetNulls returns the nulls token.
-	GetNulls() antlr.Token
-
-	// SetAsc_desc sets the asc_desc token.
-	SetAsc_desc(antlr.Token)
-
-	// SetNulls sets the nulls token.
-	SetNulls(antlr.Token)
-
 	// IsOrderClauseContext differentiates from other interfaces.
 	IsOrderClauseContext()
 }
 
 type OrderClauseContext struct {
 	*antlr.BaseParserRuleContext
-	parser   antlr.Parser
-	asc_desc antlr.Token
-	nulls    antlr.Token
+	parser antlr.Parser
 }
 
 func NewEmptyOrderClauseContext() *OrderClauseContext {
@@ -20292,14 +20368,6 @@ func NewOrderClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext,
 
 func (s *OrderClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OrderClauseContext) GetAsc_desc() antlr.Token { return s.asc_desc }
-
-func (s *OrderClauseContext) GetNulls() antlr.Token { return s.nulls }
-
-func (s *OrderClauseContext) SetAsc_desc(v antlr.Token) { s.asc_desc = v }
-
-func (s *OrderClauseContext) SetNulls(v antlr.Token) { s.nulls = v }
-
 func (s *OrderClauseContext) ORDER() antlr.TerminalNode {
 	return s.GetToken(apexParserORDER, 0)
 }
@@ -20331,24 +20399,44 @@ func (s *OrderClauseContext) SoqlField(i int) ISoqlFieldContext {
 	return t.(ISoqlFieldContext)
 }
 
-func (s *OrderClauseContext) NULLS() antlr.TerminalNode {
-	return s.GetToken(apexParserNULLS, 0)
+func (s *OrderClauseContext) AllNULLS() []antlr.TerminalNode {
+	return s.GetTokens(apexParserNULLS)
+}
+
+func (s *OrderClauseContext) NULLS(i int) antlr.TerminalNode {
+	return s.GetToken(apexParserNULLS, i)
+}
+
+func (s *OrderClauseContext) AllASC() []antlr.TerminalNode {
+	return s.GetTokens(apexParserASC)
+}
+
+func (s *OrderClauseContext) ASC(i int) antlr.TerminalNode {
+	return s.GetToken(apexParserASC, i)
 }
 
-func (s *OrderClauseContext) ASC() antlr.TerminalNode {
-	return s.GetToken(apexParserASC, 0)
+func (s *OrderClauseContext) AllDESC() []antlr.TerminalNode {
+	return s.GetTokens(apexParserDESC)
 }
 
-func (s *OrderClauseContext) DESC() antlr.TerminalNode {
-	return s.GetToken(apexParserDESC, 0)
+func (s *OrderClauseContext) DESC(i int) antlr.TerminalNode {
+	return s.GetToken(apexParserDESC, i)
 }
 
-func (s *OrderClauseContext) LAST() antlr.TerminalNode {
-	return s.GetToken(apexParserLAST, 0)
+func (s *OrderClauseContext) AllLAST() []antlr.TerminalNode {
+	return s.GetTokens(apexParserLAST)
 }
 
-func (s *OrderClauseContext) FIRST() antlr.TerminalNode {
-	return s.GetToken(apexParserFIRST, 0)
+func (s *OrderClauseContext) LAST(i int) antlr.TerminalNode {
+	return s.GetToken(apexParserLAST, i)
+}
+
+func (s *OrderClauseContext) AllFIRST() []antlr.TerminalNode {
+	return s.GetTokens(apexParserFIRST)
+}
+
+func (s *OrderClauseContext) FIRST(i int) antlr.TerminalNode {
+	return s.GetToken(apexParserFIRST, i)
 }
 
 func (s *OrderClauseContext) GetRuleContext() antlr.RuleContext {
@@ -20402,59 +20490,30 @@ func (p *apexParser) OrderClause() (localctx IOrderClauseContext) {
 		}
 	}()
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1362)
+		p.SetState(1375)
 		p.Match(apexParserORDER)
 	}
 	{
-		p.SetState(1363)
+		p.SetState(1376)
 		p.Match(apexParserBY)
 	}
 	{
-		p.SetState(1364)
+		p.SetState(1377)
 		p.SoqlField()
 	}
-	p.SetState(1369)
-	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 152, p.GetParserRuleContext())
-
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(1365)
-				p.Match(apexParserCOMMA)
-			}
-			{
-				p.SetState(1366)
-				p.SoqlField()
-			}
-
-		}
-		p.SetState(1371)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 152, p.GetParserRuleContext())
-	}
-	p.SetState(1373)
+	p.SetState(1379)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserASC || _la == apexParserDESC {
 		{
-			p.SetState(1372)
-
-			var _lt = p.GetTokenStream().LT(1)
-
-			localctx.(*OrderClauseContext).asc_desc = _lt
-
+			p.SetState(1378)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == apexParserASC || _la == apexParserDESC) {
-				var _ri = p.GetErrorHandler().RecoverInline(p)
-
-				localctx.(*OrderClauseContext).asc_desc = _ri
+				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
 				p.Consume()
@@ -20462,28 +20521,21 @@ func (p *apexParser) OrderClause() (localctx IOrderClauseContext) {
 		}
 
 	}
-	p.SetState(1377)
+	p.SetState(1383)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserNULLS {
 		{
-			p.SetState(1375)
+			p.SetState(1381)
 			p.Match(apexParserNULLS)
 		}
 		{
-			p.SetState(1376)
-
-			var _lt = p.GetTokenStream().LT(1)
-
-			localctx.(*OrderClauseContext).nulls = _lt
-
+			p.SetState(1382)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == apexParserFIRST || _la == apexParserLAST) {
-				var _ri = p.GetErrorHandler().RecoverInline(p)
-
-				localctx.(*OrderClauseContext).nulls = _ri
+				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
 				p.Consume()
@@ -20491,6 +20543,64 @@ func (p *apexParser) OrderClause() (localctx IOrderClauseContext) {
 		}
 
 	}
+	p.SetState(1396)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == apexParserCOMMA {
+		{
+			p.SetState(1385)
+			p.Match(apexParserCOMMA)
+		}
+		{
+			p.SetState(1386)
+			p.SoqlField()
+		}
+		p.SetState(1388)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == apexParserASC || _la == apexParserDESC {
+			{
+				p.SetState(1387)
+				_la = p.GetTokenStream().LA(1)
+
+				if !(_la == apexParserASC || _la == apexParserDESC) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+			}
+
+		}
+		p.SetState(1392)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == apexParserNULLS {
+			{
+				p.SetState(1390)
+				p.Match(apexParserNULLS)
+			}
+			{
+				p.SetState(1391)
+				_la = p.GetTokenStream().LA(1)
+
+				if !(_la == apexParserFIRST || _la == apexParserLAST) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+			}
+
+		}
+
+		p.SetState(1398)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
 
 	return localctx
 }
@@ -20599,11 +20709,11 @@ func (p *apexParser) BindVariable() (localctx IBindVariableContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1379)
+		p.SetState(1399)
 		p.Match(apexParserCOLON)
 	}
 	{
-		p.SetState(1380)
+		p.SetState(1400)
 		p.expression(0)
 	}
 
@@ -20732,36 +20842,36 @@ func (p *apexParser) SoqlValue() (localctx ISoqlValueContext) {
 		}
 	}()
 
-	p.SetState(1388)
+	p.SetState(1408)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserIntegerLiteral, apexParserFloatingPointLiteral, apexParserBooleanLiteral, apexParserStringLiteral, apexParserNullLiteral:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1382)
+			p.SetState(1402)
 			p.Literal()
 		}
 
 	case apexParserCOLON:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1383)
+			p.SetState(1403)
 			p.BindVariable()
 		}
 
 	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1384)
+			p.SetState(1404)
 			p.ApexIdentifier()
 		}
 		{
-			p.SetState(1385)
+			p.SetState(1405)
 			p.Match(apexParserCOLON)
 		}
 		{
-			p.SetState(1386)
+			p.SetState(1406)
 			p.Literal()
 		}
 
@@ -20884,19 +20994,19 @@ func (p *apexParser) WithClause() (localctx IWithClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1390)
+		p.SetState(1410)
 		p.Match(apexParserWITH)
 	}
 	{
-		p.SetState(1391)
+		p.SetState(1411)
 		p.Match(apexParserDATA)
 	}
 	{
-		p.SetState(1392)
+		p.SetState(1412)
 		p.Match(apexParserCATEGORY)
 	}
 	{
-		p.SetState(1393)
+		p.SetState(1413)
 		p.SoqlFilteringExpression()
 	}
 
@@ -21041,6 +21151,10 @@ func (s *GroupClauseContext) BY() antlr.TerminalNode {
 	return s.GetToken(apexParserBY, 0)
 }
 
+func (s *GroupClauseContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(apexParserLPAREN, 0)
+}
+
 func (s *GroupClauseContext) AllSoqlField() []ISoqlFieldContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISoqlFieldContext)(nil)).Elem())
 	var tst = make([]ISoqlFieldContext, len(ts))
@@ -21064,6 +21178,18 @@ func (s *GroupClauseContext) SoqlField(i int) ISoqlFieldContext {
 	return t.(ISoqlFieldContext)
 }
 
+func (s *GroupClauseContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(apexParserRPAREN, 0)
+}
+
+func (s *GroupClauseContext) ROLLUP() antlr.TerminalNode {
+	return s.GetToken(apexParserROLLUP, 0)
+}
+
+func (s *GroupClauseContext) CUBE() antlr.TerminalNode {
+	return s.GetToken(apexParserCUBE, 0)
+}
+
 func (s *GroupClauseContext) HAVING() antlr.TerminalNode {
 	return s.GetToken(apexParserHAVING, 0)
 }
@@ -21129,52 +21255,100 @@ func (p *apexParser) GroupClause() (localctx IGroupClauseContext) {
 		}
 	}()
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1397)
+		p.SetState(1417)
 		p.Match(apexParserGROUP)
 	}
 	{
-		p.SetState(1398)
+		p.SetState(1418)
 		p.Match(apexParserBY)
 	}
-	{
-		p.SetState(1399)
-		p.SoqlField()
-	}
-	p.SetState(1404)
+	p.SetState(1439)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 156, p.GetParserRuleContext())
 
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
+	switch p.GetTokenStream().LA(1) {
+	case apexParserROLLUP, apexParserCUBE:
+		{
+			p.SetState(1419)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == apexParserROLLUP || _la == apexParserCUBE) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(1420)
+			p.Match(apexParserLPAREN)
+		}
+		{
+			p.SetState(1421)
+			p.SoqlField()
+		}
+		p.SetState(1426)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == apexParserCOMMA {
 			{
-				p.SetState(1400)
+				p.SetState(1422)
 				p.Match(apexParserCOMMA)
 			}
 			{
-				p.SetState(1401)
+				p.SetState(1423)
 				p.SoqlField()
 			}
 
+			p.SetState(1428)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(1429)
+			p.Match(apexParserRPAREN)
+		}
+
+	case apexParserSET, apexParserGET, apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG, apexParserREFERENCE, apexParserOFFSET, apexParserDATA, apexParserCATEGORY, apexParserGROUP, apexParserSCOPE, apexParserTHEN, apexParserINSERT, apexParserUPSERT, apexParserUPDATE, apexParserDELETE, apexParserUNDELETE, apexParserFIND, apexParserFIELDS, apexParserRETURNING, apexParserALL, apexParserRUNAS, apexParserSYSTEM, apexParserIdentifier:
+		{
+			p.SetState(1431)
+			p.SoqlField()
 		}
-		p.SetState(1406)
+		p.SetState(1436)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 156, p.GetParserRuleContext())
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == apexParserCOMMA {
+			{
+				p.SetState(1432)
+				p.Match(apexParserCOMMA)
+			}
+			{
+				p.SetState(1433)
+				p.SoqlField()
+			}
+
+			p.SetState(1438)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(1409)
+	p.SetState(1443)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserHAVING {
 		{
-			p.SetState(1407)
+			p.SetState(1441)
 			p.Match(apexParserHAVING)
 		}
 		{
-			p.SetState(1408)
+			p.SetState(1442)
 			p.HavingConditionExpression()
 		}
 
@@ -21283,7 +21457,7 @@ func (p *apexParser) HavingConditionExpression() (localctx IHavingConditionExpre
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1411)
+		p.SetState(1445)
 		p.whereFields(0)
 	}
 
@@ -21398,22 +21572,22 @@ func (p *apexParser) OffsetClause() (localctx IOffsetClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1413)
+		p.SetState(1447)
 		p.Match(apexParserOFFSET)
 	}
-	p.SetState(1416)
+	p.SetState(1450)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserIntegerLiteral:
 		{
-			p.SetState(1414)
+			p.SetState(1448)
 			p.Match(apexParserIntegerLiteral)
 		}
 
 	case apexParserCOLON:
 		{
-			p.SetState(1415)
+			p.SetState(1449)
 			p.BindVariable()
 		}
 
@@ -21539,11 +21713,11 @@ func (p *apexParser) ViewClause() (localctx IViewClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1418)
+		p.SetState(1452)
 		p.Match(apexParserFOR)
 	}
 	{
-		p.SetState(1419)
+		p.SetState(1453)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == apexParserREFERENCE || _la == apexParserVIEW) {
@@ -21553,17 +21727,17 @@ func (p *apexParser) ViewClause() (localctx IViewClauseContext) {
 			p.Consume()
 		}
 	}
-	p.SetState(1422)
+	p.SetState(1456)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserUPDATE {
 		{
-			p.SetState(1420)
+			p.SetState(1454)
 			p.Match(apexParserUPDATE)
 		}
 		{
-			p.SetState(1421)
+			p.SetState(1455)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == apexParserVIEWSTAT || _la == apexParserTRACKING) {
@@ -21679,15 +21853,15 @@ func (p *apexParser) SoslLiteral() (localctx ISoslLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1424)
+		p.SetState(1458)
 		p.Match(apexParserLBRACK)
 	}
 	{
-		p.SetState(1425)
+		p.SetState(1459)
 		p.SoslQuery()
 	}
 	{
-		p.SetState(1426)
+		p.SetState(1460)
 		p.Match(apexParserRBRACK)
 	}
 
@@ -21736,24 +21910,24 @@ func (s *SoslQueryContext) FIND() antlr.TerminalNode {
 	return s.GetToken(apexParserFIND, 0)
 }
 
-func (s *SoslQueryContext) Literal() ILiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILiteralContext)(nil)).Elem(), 0)
+func (s *SoslQueryContext) StringLiteral() antlr.TerminalNode {
+	return s.GetToken(apexParserStringLiteral, 0)
+}
+
+func (s *SoslQueryContext) BindVariable() IBindVariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBindVariableContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ILiteralContext)
+	return t.(IBindVariableContext)
 }
 
 func (s *SoslQueryContext) IN() antlr.TerminalNode {
 	return s.GetToken(apexParserIN, 0)
 }
 
-func (s *SoslQueryContext) ALL() antlr.TerminalNode {
-	return s.GetToken(apexParserALL, 0)
-}
-
 func (s *SoslQueryContext) FIELDS() antlr.TerminalNode {
 	return s.GetToken(apexParserFIELDS, 0)
 }
@@ -21785,6 +21959,14 @@ func (s *SoslQueryContext) SoslReturningObject(i int) ISoslReturningObjectContex
 	return t.(ISoslReturningObjectContext)
 }
 
+func (s *SoslQueryContext) ALL() antlr.TerminalNode {
+	return s.GetToken(apexParserALL, 0)
+}
+
+func (s *SoslQueryContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(apexParserIdentifier, 0)
+}
+
 func (s *SoslQueryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -21838,50 +22020,86 @@ func (p *apexParser) SoslQuery() (localctx ISoslQueryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1428)
+		p.SetState(1462)
 		p.Match(apexParserFIND)
 	}
-	{
-		p.SetState(1429)
-		p.Literal()
-	}
-	{
-		p.SetState(1430)
-		p.Match(apexParserIN)
-	}
-	{
-		p.SetState(1431)
-		p.Match(apexParserALL)
-	}
-	{
-		p.SetState(1432)
-		p.Match(apexParserFIELDS)
-	}
-	{
-		p.SetState(1433)
-		p.Match(apexParserRETURNING)
+	p.SetState(1465)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case apexParserStringLiteral:
+		{
+			p.SetState(1463)
+			p.Match(apexParserStringLiteral)
+		}
+
+	case apexParserCOLON:
+		{
+			p.SetState(1464)
+			p.BindVariable()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	{
-		p.SetState(1434)
-		p.SoslReturningObject()
+	p.SetState(1470)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == apexParserIN {
+		{
+			p.SetState(1467)
+			p.Match(apexParserIN)
+		}
+		{
+			p.SetState(1468)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == apexParserALL || _la == apexParserIdentifier) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(1469)
+			p.Match(apexParserFIELDS)
+		}
+
 	}
-	p.SetState(1439)
+	p.SetState(1481)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == apexParserCOMMA {
+	if _la == apexParserRETURNING {
 		{
-			p.SetState(1435)
-			p.Match(apexParserCOMMA)
+			p.SetState(1472)
+			p.Match(apexParserRETURNING)
 		}
 		{
-			p.SetState(1436)
+			p.SetState(1473)
 			p.SoslReturningObject()
 		}
-
-		p.SetState(1441)
+		p.SetState(1478)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
+
+		for _la == apexParserCOMMA {
+			{
+				p.SetState(1474)
+				p.Match(apexParserCOMMA)
+			}
+			{
+				p.SetState(1475)
+				p.SoslReturningObject()
+			}
+
+			p.SetState(1480)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
 	}
 
 	return localctx
@@ -21925,12 +22143,59 @@ func NewSoslReturningObjectContext(parser antlr.Parser, parent antlr.ParserRuleC
 
 func (s *SoslReturningObjectContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SoslReturningObjectContext) AllIdentifier() []antlr.TerminalNode {
-	return s.GetTokens(apexParserIdentifier)
+func (s *SoslReturningObjectContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(apexParserIdentifier, 0)
 }
 
-func (s *SoslReturningObjectContext) Identifier(i int) antlr.TerminalNode {
-	return s.GetToken(apexParserIdentifier, i)
+func (s *SoslReturningObjectContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(apexParserLPAREN, 0)
+}
+
+func (s *SoslReturningObjectContext) AllSoqlField() []ISoqlFieldContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISoqlFieldContext)(nil)).Elem())
+	var tst = make([]ISoqlFieldContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ISoqlFieldContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SoslReturningObjectContext) SoqlField(i int) ISoqlFieldContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISoqlFieldContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISoqlFieldContext)
+}
+
+func (s *SoslReturningObjectContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(apexParserRPAREN, 0)
+}
+
+func (s *SoslReturningObjectContext) WhereClause() IWhereClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWhereClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWhereClauseContext)
+}
+
+func (s *SoslReturningObjectContext) LimitClause() ILimitClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILimitClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
 }
 
 func (s *SoslReturningObjectContext) GetRuleContext() antlr.RuleContext {
@@ -21986,42 +22251,64 @@ func (p *apexParser) SoslReturningObject() (localctx ISoslReturningObjectContext
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1442)
+		p.SetState(1483)
 		p.Match(apexParserIdentifier)
 	}
-	p.SetState(1453)
+	p.SetState(1501)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == apexParserLPAREN {
 		{
-			p.SetState(1443)
+			p.SetState(1484)
 			p.Match(apexParserLPAREN)
 		}
 		{
-			p.SetState(1444)
-			p.Match(apexParserIdentifier)
+			p.SetState(1485)
+			p.SoqlField()
 		}
-		p.SetState(1449)
+		p.SetState(1490)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == apexParserCOMMA {
 			{
-				p.SetState(1445)
+				p.SetState(1486)
 				p.Match(apexParserCOMMA)
 			}
 			{
-				p.SetState(1446)
-				p.Match(apexParserIdentifier)
+				p.SetState(1487)
+				p.SoqlField()
 			}
 
-			p.SetState(1451)
+			p.SetState(1492)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
+		p.SetState(1494)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == apexParserWHERE {
+			{
+				p.SetState(1493)
+				p.WhereClause()
+			}
+
+		}
+		p.SetState(1497)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == apexParserLIMIT {
+			{
+				p.SetState(1496)
+				p.LimitClause()
+			}
+
+		}
 		{
-			p.SetState(1452)
+			p.SetState(1499)
 			p.Match(apexParserRPAREN)
 		}
 
@@ -22212,161 +22499,161 @@ func (p *apexParser) ApexIdentifier() (localctx IApexIdentifierContext) {
 		}
 	}()
 
-	p.SetState(1477)
+	p.SetState(1525)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case apexParserIdentifier:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1455)
+			p.SetState(1503)
 			p.Match(apexParserIdentifier)
 		}
 
 	case apexParserGET:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1456)
+			p.SetState(1504)
 			p.Match(apexParserGET)
 		}
 
 	case apexParserSET:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1457)
+			p.SetState(1505)
 			p.Match(apexParserSET)
 		}
 
 	case apexParserDATA:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1458)
+			p.SetState(1506)
 			p.Match(apexParserDATA)
 		}
 
 	case apexParserGROUP:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1459)
+			p.SetState(1507)
 			p.Match(apexParserGROUP)
 		}
 
 	case apexParserDELETE:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1460)
+			p.SetState(1508)
 			p.Match(apexParserDELETE)
 		}
 
 	case apexParserINSERT:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(1461)
+			p.SetState(1509)
 			p.Match(apexParserINSERT)
 		}
 
 	case apexParserUPDATE:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(1462)
+			p.SetState(1510)
 			p.Match(apexParserUPDATE)
 		}
 
 	case apexParserUNDELETE:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(1463)
+			p.SetState(1511)
 			p.Match(apexParserUNDELETE)
 		}
 
 	case apexParserUPSERT:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(1464)
+			p.SetState(1512)
 			p.Match(apexParserUPSERT)
 		}
 
 	case apexParserSCOPE:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(1465)
+			p.SetState(1513)
 			p.Match(apexParserSCOPE)
 		}
 
 	case apexParserCATEGORY:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(1466)
+			p.SetState(1514)
 			p.Match(apexParserCATEGORY)
 		}
 
 	case apexParserREFERENCE:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(1467)
+			p.SetState(1515)
 			p.Match(apexParserREFERENCE)
 		}
 
 	case apexParserOFFSET:
 		p.EnterOuterAlt(localctx, 14)
 		{
-			p.SetState(1468)
+			p.SetState(1516)
 			p.Match(apexParserOFFSET)
 		}
 
 	case apexParserTHEN:
 		p.EnterOuterAlt(localctx, 15)
 		{
-			p.SetState(1469)
+			p.SetState(1517)
 			p.Match(apexParserTHEN)
 		}
 
 	case apexParserFIND:
 		p.EnterOuterAlt(localctx, 16)
 		{
-			p.SetState(1470)
+			p.SetState(1518)
 			p.Match(apexParserFIND)
 		}
 
 	case apexParserRETURNING:
 		p.EnterOuterAlt(localctx, 17)
 		{
-			p.SetState(1471)
+			p.SetState(1519)
 			p.Match(apexParserRETURNING)
 		}
 
 	case apexParserALL:
 		p.EnterOuterAlt(localctx, 18)
 		{
-			p.SetState(1472)
+			p.SetState(1520)
 			p.Match(apexParserALL)
 		}
 
 	case apexParserFIELDS:
 		p.EnterOuterAlt(localctx, 19)
 		{
-			p.SetState(1473)
+			p.SetState(1521)
 			p.Match(apexParserFIELDS)
 		}
 
 	case apexParserRUNAS:
 		p.EnterOuterAlt(localctx, 20)
 		{
-			p.SetState(1474)
+			p.SetState(1522)
 			p.Match(apexParserRUNAS)
 		}
 
 	case apexParserSYSTEM:
 		p.EnterOuterAlt(localctx, 21)
 		{
-			p.SetState(1475)
+			p.SetState(1523)
 			p.Match(apexParserSYSTEM)
 		}
 
 	case apexParserBOOLEAN, apexParserDOUBLE, apexParserFLOAT, apexParserINTEGER, apexParserSTRING, apexParserLONG:
 		p.EnterOuterAlt(localctx, 22)
 		{
-			p.SetState(1476)
+			p.SetState(1524)
 			p.PrimitiveType()
 		}
 
@@ -22528,7 +22815,7 @@ func (p *apexParser) TypeIdentifier() (localctx ITypeIdentifierContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1479)
+		p.SetState(1527)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == apexParserSET || _la == apexParserGET || (((_la-66)&-(0x1f+1)) == 0 && ((1<<uint((_la-66)))&((1<<(apexParserREFERENCE-66))|(1<<(apexParserOFFSET-66))|(1<<(apexParserDATA-66))|(1<<(apexParserCATEGORY-66))|(1<<(apexParserGROUP-66))|(1<<(apexParserSCOPE-66))|(1<<(apexParserTHEN-66))|(1<<(apexParserFIND-66))|(1<<(apexParserFIELDS-66))|(1<<(apexParserRETURNING-66))|(1<<(apexParserALL-66)))) != 0) || _la == apexParserSYSTEM || _la == apexParserIdentifier) {
@@ -0,0 +1,59 @@
+package parser
+
+import "github.com/antlr/antlr4/runtime/Go/antlr"
+
+// KeywordTokenSource turns keywords into identifiers where they are used as names,
+// such as `Trigger.new` and `Trigger.old`, which apexIdentifier does not accept
+type KeywordTokenSource struct {
+	antlr.Lexer
+	prev    antlr.Token
+	pending []antlr.Token
+}
+
+func NewKeywordTokenSource(lexer antlr.Lexer) *KeywordTokenSource {
+	return &KeywordTokenSource{
+		Lexer:   lexer,
+		pending: []antlr.Token{},
+	}
+}
+
+func (s *KeywordTokenSource) NextToken() antlr.Token {
+	t := s.next()
+	switch t.GetTokenType() {
+	case apexLexerTRIGGER:
+		// Trigger.new, not `trigger Foo on Account (...)`
+		next := s.next()
+		s.pending = append(s.pending, next)
+		if next.GetTokenType() == apexLexerDOT {
+			t = s.toIdentifier(t)
+		}
+	case apexLexerNEW:
+		if s.prev != nil && s.prev.GetTokenType() == apexLexerDOT {
+			t = s.toIdentifier(t)
+		}
+	}
+	s.prev = t
+	return t
+}
+
+func (s *KeywordTokenSource) next() antlr.Token {
+	if len(s.pending) > 0 {
+		t := s.pending[0]
+		s.pending = s.pending[1:]
+		return t
+	}
+	return s.Lexer.NextToken()
+}
+
+func (s *KeywordTokenSource) toIdentifier(t antlr.Token) antlr.Token {
+	return s.GetTokenFactory().Create(
+		t.GetSource(),
+		apexLexerIdentifier,
+		t.GetText(),
+		t.GetChannel(),
+		t.GetStart(),
+		t.GetStop(),
+		t.GetLine(),
+		t.GetColumn(),
+	)
+}
@@ -9,6 +9,9 @@ import (
 type SoqlChecker struct{}
 
 func (v *SoqlChecker) VisitClassType(n *ast.ClassType) (interface{}, error) {
+	if n.IsEnum() {
+		return nil, nil
+	}
 	for _, methods := range n.InstanceMethods.All() {
 		for _, method := range methods {
 			_, err := method.Statements.Accept(v)
@@ -44,6 +47,10 @@ func (v *SoqlChecker) VisitInterfaceDeclaration(n *ast.InterfaceDeclaration) (in
 	return ast.VisitInterfaceDeclaration(v, n)
 }
 
+func (v *SoqlChecker) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	return ast.VisitEnumDeclaration(v, n)
+}
+
 func (v *SoqlChecker) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
 	return ast.VisitIntegerLiteral(v, n)
 }